@@ -3,13 +3,21 @@ package cmd
 import (
 	"fmt"
 	"github.com/PauloMigAlmeida/fretboard-games/game"
-	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
 	"github.com/spf13/cobra"
 	"os"
 	"os/signal"
 	"syscall"
 )
 
+var (
+	findnoteKey        string
+	findnoteTuning     string
+	findnoteInstrument string
+	findnoteCapo       string
+	findnoteFromCapo   bool
+)
+
 var findnoteCmd = &cobra.Command{
 	Use:   "findnote",
 	Short: "Interactive fretboard training game to find specific notes on guitar strings",
@@ -35,12 +43,55 @@ GAME FLOW:
 4. If incorrect, the game shows a fretboard visualization highlighting the correct positions
 
 5. Track your progress with built-in statistics showing correct/incorrect answers
+
+Use --key to spell the notes as they would be written in a given key (e.g. --key Bb shows Eb
+instead of D#).
+
+Use --tuning to play on another tuning, either by name (e.g. "drop d", "dadgad", "open g") or by
+listing the open strings from the lowest one (e.g. "DADGAD", "C G C F A D" or "E2 A2 D3 G3 B3 E4").
+
+Use --instrument to play on something other than a 6-string guitar: bass, 5-string bass,
+6-string bass, 7-string guitar, 8-string guitar, ukulele, mandolin, tenor guitar or banjo. The
+instrument's own tuning is used unless --tuning is given as well. Custom instruments can be
+described in a YAML or JSON file and loaded with --instrument path/to/instrument.yaml:
+
+   name: baritone 7
+   tuning: A1 D2 G2 C3 F3 A3 D4
+   frets: 24
+   inlays: [3, 5, 7, 9, 12, 15, 17, 19, 21, 24]
+   scale_length: 27
+
+Use --capo to play with a capo on a given fret (e.g. --capo 2), or a partial capo by listing the
+strings it covers (e.g. --capo 2:3,4,5). Frets behind the capo can't be played and answers are
+counted from the nut unless --from-capo is given, which makes the capo fret 0.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		fretboard := instrument.NewFretboard(24, instrument.StandardTuning())
+		fretboard, err := newFretboard(findnoteInstrument, findnoteTuning)
+		if err != nil {
+			fmt.Println("Error building the fretboard:", err)
+			os.Exit(-1)
+		}
+
+		if findnoteCapo != "" {
+			err = putCapo(fretboard, findnoteCapo, findnoteFromCapo)
+			if err != nil {
+				fmt.Println("Error putting the capo on:", err)
+				os.Exit(-1)
+			}
+		}
+
 		game := game.NewFindNoteGame(fretboard, os.Stdin, os.Stdout, game.NoSeed)
 
-		err := game.Configure()
+		if findnoteKey != "" {
+			key, err := music.ParseKey(findnoteKey)
+			if err != nil {
+				fmt.Println("Error parsing key:", err)
+				os.Exit(-1)
+			}
+			game.Key = key
+		}
+
+		err = game.Configure()
 		if err != nil {
 			fmt.Println("Error configuring the game:", err)
 			os.Exit(-1)
@@ -67,5 +118,10 @@ GAME FLOW:
 }
 
 func init() {
+	findnoteCmd.Flags().StringVar(&findnoteKey, "key", "", "key used to spell the notes (e.g. C, Bb, F#m)")
+	findnoteCmd.Flags().StringVar(&findnoteTuning, "tuning", "", "tuning name or open string notes from the lowest string (e.g. \"drop d\", DADGAD)")
+	findnoteCmd.Flags().StringVar(&findnoteInstrument, "instrument", "guitar", "instrument to play on (e.g. bass, ukulele, mandolin, banjo) or a YAML/JSON instrument file")
+	findnoteCmd.Flags().StringVar(&findnoteCapo, "capo", "", "fret to put a capo on, optionally with the strings it covers (e.g. 2 or 2:3,4,5)")
+	findnoteCmd.Flags().BoolVar(&findnoteFromCapo, "from-capo", false, "count frets from the capo instead of the nut")
 	rootCmd.AddCommand(findnoteCmd)
 }
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+)
+
+var (
+	fretsInstrument    string
+	fretsScaleLength   float64
+	fretsCount         int
+	fretsBass          float64
+	fretsTreble        float64
+	fretsPerpendicular int
+)
+
+var fretsCmd = &cobra.Command{
+	Use:   "frets",
+	Short: "Print where the frets go for a scale length",
+	Long: `Print the distance from the nut to every fret, and between frets, for a scale length using
+equal temperament spacing without compensation.
+
+EXAMPLES:
+   fretboard-games frets --scale 25.5
+   fretboard-games frets --scale 648 --frets 19
+   fretboard-games frets --instrument bass
+   fretboard-games frets --bass 27 --treble 25.5 --perpendicular 7 --instrument guitar7
+
+Distances use the same unit as the scale length, so scale lengths in millimetres give a table in
+millimetres. Instruments have their scale length in inches.
+
+Multiscale (fanned-fret) boards are described by the scale length of the lowest string (--bass),
+of the highest one (--treble) and the fret that's square to the neck (--perpendicular). Their
+table has a column per string, along with where the nut of each string sits relative to the
+perpendicular fret.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		table, err := fretsTable()
+		if err != nil {
+			fmt.Println("Error working out the frets:", err)
+			os.Exit(-1)
+		}
+
+		fmt.Print(table)
+	},
+}
+
+func fretsTable() (string, error) {
+	profile, err := findProfile(fretsInstrument)
+	if err != nil {
+		return "", err
+	}
+
+	fretCount := profile.Frets
+	if fretsCount > 0 {
+		fretCount = fretsCount
+	}
+
+	if fretsBass != 0 || fretsTreble != 0 {
+		multiscale, err := instrument.NewMultiscale(fretsBass, fretsTreble, fretsPerpendicular)
+		if err != nil {
+			return "", err
+		}
+		return multiscaleTable(multiscale, profile.StringCount(), fretCount)
+	}
+
+	if profile.Multiscale != nil && fretsScaleLength == 0 {
+		return multiscaleTable(profile.Multiscale, profile.StringCount(), fretCount)
+	}
+
+	scaleLength := profile.ScaleLength
+	if fretsScaleLength != 0 {
+		scaleLength = fretsScaleLength
+	}
+
+	positions, err := instrument.FretTable(scaleLength, fretCount)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Scale length %g\n\n", scaleLength))
+	sb.WriteString(fmt.Sprintf("%-6s%12s%16s\n", "Fret", "From nut", "From previous"))
+	for _, position := range positions {
+		sb.WriteString(fmt.Sprintf("%-6d%12.3f%16.3f\n", position.Fret, position.FromNut, position.FromPreviousFret))
+	}
+
+	return sb.String(), nil
+}
+
+func multiscaleTable(multiscale *instrument.Multiscale, stringCount int, fretCount int) (string, error) {
+	tables := make([][]instrument.FretPosition, stringCount)
+	for i := range tables {
+		table, err := multiscale.FretTable(i+1, stringCount, fretCount)
+		if err != nil {
+			return "", err
+		}
+		tables[i] = table
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Multiscale %g (bass) to %g (treble), perpendicular at fret %d\n\n",
+		multiscale.BassScaleLength, multiscale.TrebleScaleLength, multiscale.PerpendicularFret))
+
+	// strings are listed from the lowest one, the way the board is usually drawn
+	sb.WriteString(fmt.Sprintf("%-12s", "String"))
+	for stringNumber := stringCount; stringNumber >= 1; stringNumber-- {
+		sb.WriteString(fmt.Sprintf("%10d", stringNumber))
+	}
+	sb.WriteString(fmt.Sprintf("\n%-12s", "Scale"))
+	for stringNumber := stringCount; stringNumber >= 1; stringNumber-- {
+		sb.WriteString(fmt.Sprintf("%10.3f", multiscale.ScaleLength(stringNumber, stringCount)))
+	}
+	sb.WriteString(fmt.Sprintf("\n%-12s", "Nut offset"))
+	for stringNumber := stringCount; stringNumber >= 1; stringNumber-- {
+		sb.WriteString(fmt.Sprintf("%10.3f", multiscale.NutOffset(stringNumber, stringCount)))
+	}
+	sb.WriteString("\n\nDistance from the nut of each string\n")
+
+	for fretIdx := range fretCount {
+		sb.WriteString(fmt.Sprintf("%-12s", fmt.Sprintf("Fret %d", fretIdx+1)))
+		for stringNumber := stringCount; stringNumber >= 1; stringNumber-- {
+			sb.WriteString(fmt.Sprintf("%10.3f", tables[stringNumber-1][fretIdx].FromNut))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), nil
+}
+
+func init() {
+	fretsCmd.Flags().StringVar(&fretsInstrument, "instrument", "guitar", "instrument (or YAML/JSON instrument file) to take the scale length and frets from")
+	fretsCmd.Flags().Float64Var(&fretsScaleLength, "scale", 0, "scale length, overriding the instrument's")
+	fretsCmd.Flags().IntVar(&fretsCount, "frets", 0, "number of frets, overriding the instrument's")
+	fretsCmd.Flags().Float64Var(&fretsBass, "bass", 0, "scale length of the lowest string of a multiscale board")
+	fretsCmd.Flags().Float64Var(&fretsTreble, "treble", 0, "scale length of the highest string of a multiscale board")
+	fretsCmd.Flags().IntVar(&fretsPerpendicular, "perpendicular", 0, "fret that's square to the neck on a multiscale board")
+	rootCmd.AddCommand(fretsCmd)
+}
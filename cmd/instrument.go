@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+)
+
+// newFretboard builds the fretboard of an instrument profile, or of an instrument file when
+// given a path, replacing its tuning when one is given.
+func newFretboard(instrumentName string, tuningName string) (*instrument.Fretboard, error) {
+	profile, err := findProfile(instrumentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return profileFretboard(profile, tuningName)
+}
+
+// profileFretboard builds the fretboard of an instrument profile that's already been found,
+// replacing its tuning when one is given.
+func profileFretboard(profile *instrument.Profile, tuningName string) (*instrument.Fretboard, error) {
+	if tuningName != "" {
+		tuning, err := instrument.ParseTuning(tuningName)
+		if err != nil {
+			return nil, err
+		}
+		profile.Tuning = tuning
+	}
+
+	return profile.NewFretboard(), nil
+}
+
+// findProfile loads the instrument file when given a path, or looks up a known instrument
+// otherwise.
+func findProfile(instrumentName string) (*instrument.Profile, error) {
+	if instrument.IsProfileFile(instrumentName) {
+		return instrument.LoadProfile(instrumentName)
+	}
+
+	return instrument.FindProfile(instrumentName)
+}
+
+// putCapo parses a capo (e.g. "2" or "2:3,4,5") and puts it on the fretboard.
+func putCapo(fretboard *instrument.Fretboard, capoDescription string, fromCapo bool) error {
+	capo, err := instrument.ParseCapo(capoDescription)
+	if err != nil {
+		return err
+	}
+
+	if fromCapo {
+		fretboard.FretReference = instrument.FretsFromCapo
+	}
+
+	return fretboard.SetCapo(capo)
+}
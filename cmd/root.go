@@ -3,14 +3,26 @@ package cmd
 import (
 	"os"
 
+	"github.com/PauloMigAlmeida/fretboard-games/music"
 	"github.com/spf13/cobra"
 )
 
+var namingSystem string
+
 var rootCmd = &cobra.Command{
 	Use:   "fretboard-games",
 	Short: "Interactive guitar fretboard training games",
 	Long: `Fretboard Games is a collection of interactive training tools designed to help 
 guitarists improve their fretboard knowledge and note recognition skills.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		system, err := music.FindNamingSystem(namingSystem)
+		if err != nil {
+			return err
+		}
+
+		music.SetDefaultNamingSystem(system)
+		return nil
+	},
 }
 
 func Execute() {
@@ -19,3 +31,7 @@ func Execute() {
 		os.Exit(1)
 	}
 }
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&namingSystem, "naming", music.EnglishNaming.Name, "note naming system: english, unicode, german (H/B) or solfege (Do Re Mi)")
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+)
+
+var (
+	tensionInstrument string
+	tensionTuning     string
+	tensionGauges     string
+	tensionMin        float64
+	tensionMax        float64
+)
+
+var tensionCmd = &cobra.Command{
+	Use:   "tension",
+	Short: "Work out the tension of each string for a set of gauges and a tuning",
+	Long: `Work out the tension of each string from its gauge, the scale length of the instrument and
+the pitch it's tuned to, warning about strings that would be too slack or too tight. Handy to
+try drop tunings before restringing.
+
+EXAMPLES:
+   fretboard-games tension --gauges "10 13 17 26 36 46"
+   fretboard-games tension --gauges "regular light" --tuning "drop c"
+   fretboard-games tension --instrument bass --gauges "45 65 80 100"
+   fretboard-games tension --instrument guitar7 --gauges "10-13-17-26-36-46-59" --min 13
+
+Gauges are listed from string 1 (the highest) to the lowest string, either in thousandths of an
+inch with an optional p (plain) or w (wound), or with string maker codes such as PL010, NW046 or
+XB105. Gauges under .020 are plain unless marked with a w. Known sets: super light, regular
+light, medium, heavy, 7-string regular light, bass regular light and 5-string bass regular
+light.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		report, err := tensionReport()
+		if err != nil {
+			fmt.Println("Error working out the tension:", err)
+			os.Exit(-1)
+		}
+
+		fmt.Print(report)
+	},
+}
+
+func tensionReport() (string, error) {
+	profile, err := findProfile(tensionInstrument)
+	if err != nil {
+		return "", err
+	}
+
+	fretboard, err := profileFretboard(profile, tensionTuning)
+	if err != nil {
+		return "", err
+	}
+
+	gauges, err := instrument.ParseStringGauges(tensionGauges)
+	if err != nil {
+		return "", err
+	}
+
+	limits := profile.TensionLimits
+	if tensionMin != 0 {
+		limits.Min = tensionMin
+	}
+	if tensionMax != 0 {
+		limits.Max = tensionMax
+	}
+
+	tensions, err := fretboard.Tensions(gauges, limits)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s on a %s\n\n", fretboard.Tuning.String(), profile.Name))
+	sb.WriteString(fmt.Sprintf("%-8s%-7s%-8s%10s%14s\n", "String", "Pitch", "Gauge", "Hz", "Tension (lb)"))
+
+	total := 0.0
+	warnings := 0
+	for _, tension := range tensions {
+		sb.WriteString(fmt.Sprintf("%-8d%-7s%-8s%10.2f%14.1f", tension.StringNumber, tension.Pitch.String(),
+			tension.Gauge.Name, tension.Frequency, tension.Tension))
+		if tension.Status != instrument.TensionOK {
+			sb.WriteString("  " + tension.Status.String())
+			warnings++
+		}
+		sb.WriteString("\n")
+		total += tension.Tension
+	}
+
+	sb.WriteString(fmt.Sprintf("\nTotal tension: %.1f lb\n", total))
+	if warnings > 0 {
+		sb.WriteString(fmt.Sprintf("Warning: %d string(s) outside %g to %g lb\n", warnings, limits.Min, limits.Max))
+	}
+
+	return sb.String(), nil
+}
+
+func init() {
+	tensionCmd.Flags().StringVar(&tensionInstrument, "instrument", "guitar", "instrument (or YAML/JSON instrument file) the strings are on")
+	tensionCmd.Flags().StringVar(&tensionTuning, "tuning", "", "tuning name or open string notes from the lowest string, defaulting to the instrument's")
+	tensionCmd.Flags().StringVar(&tensionGauges, "gauges", "regular light", "string set name or gauges from string 1 to the lowest string")
+	tensionCmd.Flags().Float64Var(&tensionMin, "min", 0, "tension in pounds below which a string is too slack, overriding the instrument's")
+	tensionCmd.Flags().Float64Var(&tensionMax, "max", 0, "tension in pounds above which a string is too tight, overriding the instrument's")
+	rootCmd.AddCommand(tensionCmd)
+}
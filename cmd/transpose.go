@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+)
+
+var (
+	transposeInterval string
+	transposeDown     bool
+	transposeFrom     string
+	transposeTo       string
+	transposeCapo     int
+)
+
+// intervals used to move chord shapes down by the number of frets a capo is placed on
+var capoIntervals = []music.Interval{
+	music.PerfectUnison, music.MinorSecond, music.MajorSecond, music.MinorThird, music.MajorThird,
+	music.PerfectFourth, music.DiminishedFifth, music.PerfectFifth, music.MinorSixth, music.MajorSixth,
+	music.MinorSeventh, music.MajorSeventh,
+}
+
+var transposeCmd = &cobra.Command{
+	Use:   "transpose <progression>",
+	Short: "Transpose notes, chords and chord progressions",
+	Long: `Transpose a chord progression (or a single chord or note) by an interval, to another key
+or to the shapes you play with a capo.
+
+EXAMPLES:
+   fretboard-games transpose "C Am | F G7" --interval M2
+   fretboard-games transpose "G D/F# Em C" --interval P4 --down
+   fretboard-games transpose "G D/F# Em C" --from G --to Eb
+   fretboard-games transpose "Eb Cm Ab Bb7" --capo 3
+
+When transposing to a key, chords are spelled the way the destination key expects (e.g. Bb
+instead of A# in F major). Both keys have to be major, or both minor (e.g. --from Am --to Em).
+`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		progression := strings.Join(args, " ")
+
+		transposed, err := transposeProgression(progression)
+		if err != nil {
+			fmt.Println("Error transposing:", err)
+			os.Exit(-1)
+		}
+
+		fmt.Println(transposed)
+	},
+}
+
+func transposeProgression(progression string) (string, error) {
+	switch {
+	case transposeFrom != "" || transposeTo != "":
+		if transposeFrom == "" || transposeTo == "" {
+			return "", fmt.Errorf("--from and --to have to be used together")
+		}
+
+		from, err := music.ParseKey(transposeFrom)
+		if err != nil {
+			return "", err
+		}
+
+		to, err := music.ParseKey(transposeTo)
+		if err != nil {
+			return "", err
+		}
+
+		return music.TransposeProgressionToKey(progression, from, to)
+	case transposeCapo != 0:
+		if transposeCapo < 0 || transposeCapo >= len(capoIntervals) {
+			return "", fmt.Errorf("capo has to be between fret 1 and %d", len(capoIntervals)-1)
+		}
+
+		return music.TransposeProgression(progression, capoIntervals[transposeCapo], music.TransposeDown)
+	case transposeInterval != "":
+		interval, err := music.ParseInterval(transposeInterval)
+		if err != nil {
+			return "", err
+		}
+
+		direction := music.TransposeUp
+		if transposeDown {
+			direction = music.TransposeDown
+		}
+
+		return music.TransposeProgression(progression, interval, direction)
+	default:
+		return "", fmt.Errorf("use --interval, --from and --to, or --capo to say where to transpose to")
+	}
+}
+
+func init() {
+	transposeCmd.Flags().StringVar(&transposeInterval, "interval", "", "interval to transpose by (e.g. M2, m3, P5)")
+	transposeCmd.Flags().BoolVar(&transposeDown, "down", false, "transpose down by the interval instead of up")
+	transposeCmd.Flags().StringVar(&transposeFrom, "from", "", "key the progression is written in (e.g. G, Em)")
+	transposeCmd.Flags().StringVar(&transposeTo, "to", "", "key to transpose the progression to (e.g. Bb, Gm)")
+	transposeCmd.Flags().IntVar(&transposeCapo, "capo", 0, "fret the capo is on, printing the chord shapes to play")
+	rootCmd.AddCommand(transposeCmd)
+}
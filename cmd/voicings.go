@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/spf13/cobra"
+	"os"
+	"slices"
+	"strings"
+)
+
+var (
+	voicingsInstrument   string
+	voicingsTuning       string
+	voicingsCapo         string
+	voicingsSpan         int
+	voicingsFingers      int
+	voicingsRootInBass   bool
+	voicingsNoMutedInner bool
+	voicingsFamily       string
+	voicingsLimit        int
+)
+
+var voicingsCmd = &cobra.Command{
+	Use:   "voicings <chord | notes>",
+	Short: "List playable voicings of a chord, from the easiest one",
+	Long: `List the ways a chord (or a set of notes) can be played on an instrument, ranked by how hard
+they are to play: how far the hand stretches, how many fingers and barres they need and how many
+strings are muted.
+
+EXAMPLES:
+   fretboard-games voicings C
+   fretboard-games voicings Cmaj7 --family drop2 --no-muted-inner
+   fretboard-games voicings "D/F#" --limit 20
+   fretboard-games voicings A C# E G --root-in-bass
+   fretboard-games voicings Am7 --instrument ukulele
+   fretboard-games voicings G --tuning "open d" --span 5 --fingers 3
+
+Notes are given from the bass note, which is the one --root-in-bass puts on the lowest string
+played. Frets are listed from the lowest string and counted from the nut, with an x for muted
+strings and dashes between them when any fret has two digits. Families are any, close, drop 2
+and drop 3.
+`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		report, err := voicingsReport(strings.Join(args, " "))
+		if err != nil {
+			fmt.Println("Error finding voicings:", err)
+			os.Exit(-1)
+		}
+
+		fmt.Print(report)
+	},
+}
+
+func voicingsReport(chordDescription string) (string, error) {
+	fretboard, err := newFretboard(voicingsInstrument, voicingsTuning)
+	if err != nil {
+		return "", err
+	}
+
+	if voicingsCapo != "" {
+		if err := putCapo(fretboard, voicingsCapo, false); err != nil {
+			return "", err
+		}
+	}
+
+	family, err := instrument.ParseVoicingFamily(voicingsFamily)
+	if err != nil {
+		return "", err
+	}
+
+	options := instrument.VoicingOptions{
+		MaxFretSpan:         voicingsSpan,
+		MaxFingers:          voicingsFingers,
+		RootInBass:          voicingsRootInBass,
+		NoMutedInnerStrings: voicingsNoMutedInner,
+		Family:              family,
+	}
+
+	name, notes, voicings, err := findVoicings(fretboard, chordDescription, options)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s on %s\n", name, fretboard.Tuning.String()))
+	if fretboard.Capo != nil {
+		sb.WriteString(fmt.Sprintf("with a %s\n", fretboard.Capo.String()))
+	}
+	sb.WriteString(fmt.Sprintf("\n%-20s%-18s%-24s%6s\n", "Frets", "Fingers", "Notes", "Cost"))
+
+	for i, voicing := range voicings {
+		if voicingsLimit > 0 && i == voicingsLimit {
+			break
+		}
+
+		noteNames := make([]string, 0, len(voicing.Frets))
+		for _, position := range voicing.Positions() {
+			note, err := fretboard.GetNoteAt(position.StringNumber, position.FretNumber)
+			if err != nil {
+				return "", err
+			}
+
+			// spelled the way the chord spells it (e.g. Bb rather than A# in C7)
+			if idx := slices.IndexFunc(notes, note.EnharmonicEquals); idx >= 0 {
+				note = notes[idx]
+			}
+			noteNames = append(noteNames, note.String())
+		}
+
+		fingers := voicing.FingerChart()
+		if voicing.Barre {
+			fingers += " (barre)"
+		}
+
+		sb.WriteString(fmt.Sprintf("%-20s%-18s%-24s%6.2f\n", voicing.String(), fingers, strings.Join(noteNames, " "), voicing.Cost))
+	}
+
+	if voicingsLimit > 0 && len(voicings) > voicingsLimit {
+		sb.WriteString(fmt.Sprintf("\n%d more voicings, use --limit to see them\n", len(voicings)-voicingsLimit))
+	}
+
+	return sb.String(), nil
+}
+
+// findVoicings parses a chord symbol, or notes from the bass note when there's more than one,
+// naming them after the chord they make when they make one.
+func findVoicings(fretboard *instrument.Fretboard, chordDescription string, options instrument.VoicingOptions) (string, []*music.Note, []instrument.Voicing, error) {
+	noteNames := strings.Fields(chordDescription)
+	if len(noteNames) == 1 {
+		chord, err := music.ParseChord(noteNames[0])
+		if err != nil {
+			return "", nil, nil, err
+		}
+
+		voicings, err := fretboard.ChordVoicings(chord, options)
+		return chord.String(), append(chord.Notes(), chord.Bass), voicings, err
+	}
+
+	notes := make([]*music.Note, len(noteNames))
+	for i, noteName := range noteNames {
+		note, err := music.ParseNote(noteName)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		notes[i] = note
+	}
+
+	name := strings.Join(noteNames, " ")
+	if candidates, err := music.IdentifyChord(notes); err == nil && len(candidates) > 0 {
+		name = fmt.Sprintf("%s (%s)", candidates[0].Name, name)
+	}
+
+	voicings, err := fretboard.Voicings(notes, notes[0], options)
+	return name, notes, voicings, err
+}
+
+func init() {
+	voicingsCmd.Flags().StringVar(&voicingsInstrument, "instrument", "guitar", "instrument (or YAML/JSON instrument file) to play the chord on")
+	voicingsCmd.Flags().StringVar(&voicingsTuning, "tuning", "", "tuning name or open string notes from the lowest string, defaulting to the instrument's")
+	voicingsCmd.Flags().StringVar(&voicingsCapo, "capo", "", "fret to put a capo on, optionally with the strings it covers (e.g. 2 or 2:3,4,5)")
+	voicingsCmd.Flags().IntVar(&voicingsSpan, "span", 4, "most frets the fretted notes can cover")
+	voicingsCmd.Flags().IntVar(&voicingsFingers, "fingers", 4, "most fingers a voicing can need")
+	voicingsCmd.Flags().BoolVar(&voicingsRootInBass, "root-in-bass", false, "only list voicings with the bass note on the lowest string played")
+	voicingsCmd.Flags().BoolVar(&voicingsNoMutedInner, "no-muted-inner", false, "only list voicings without muted strings between the strings played")
+	voicingsCmd.Flags().StringVar(&voicingsFamily, "family", "any", "voicing family: any, close, drop 2 or drop 3")
+	voicingsCmd.Flags().IntVar(&voicingsLimit, "limit", 10, "most voicings to list, 0 for all of them")
+	rootCmd.AddCommand(voicingsCmd)
+}
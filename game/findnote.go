@@ -23,6 +23,8 @@ type FindNoteGame struct {
 	// game variables
 	NotesAmount   int
 	StringsAmount int
+	// Key is optional and, when set, is used to spell the notes shown to the player
+	Key *music.Key
 	// OS stuff
 	StdIn  io.Reader
 	StdOut io.Writer
@@ -72,8 +74,10 @@ func (f *FindNoteGame) Configure() error {
 		return fmt.Errorf("invalid strings amount, has to be between 1 and %d", len(f.Fretboard.Strings))
 	}
 
-	if f.NotesAmount < 1 || f.NotesAmount > len(f.Fretboard.Strings[0].FretNotes) {
-		return fmt.Errorf("invalid notes amount, has to be between 1 and %d", len(f.Fretboard.Strings[0].FretNotes))
+	// notes are told apart by pitch class, so there are never more than 12 of them to find
+	maxNotesAmount := min(f.Fretboard.FretCount(), music.PitchClassCount)
+	if f.NotesAmount < 1 || f.NotesAmount > maxNotesAmount {
+		return fmt.Errorf("invalid notes amount, has to be between 1 and %d", maxNotesAmount)
 	}
 
 	return nil
@@ -129,8 +133,12 @@ func (f *FindNoteGame) buildAnswer() (map[int]map[int]*music.Note, error) {
 		return nil, fmt.Errorf("fretboard has less strings (%d) than the requested number of strings (%d)", len(f.Fretboard.Strings), f.StringsAmount)
 	}
 
-	if len(f.Fretboard.Strings[0].FretNotes) < f.NotesAmount {
-		return nil, fmt.Errorf("fretboard has less frets (%d) than requested notes to find (%d)", len(f.Fretboard.Strings[0].FretNotes), f.NotesAmount)
+	if f.Fretboard.FretCount() < f.NotesAmount {
+		return nil, fmt.Errorf("fretboard has less frets (%d) than requested notes to find (%d)", f.Fretboard.FretCount(), f.NotesAmount)
+	}
+
+	if music.PitchClassCount < f.NotesAmount {
+		return nil, fmt.Errorf("there are less pitch classes (%d) than requested notes to find (%d)", music.PitchClassCount, f.NotesAmount)
 	}
 
 	selectedStrings := make(map[int]bool)
@@ -143,19 +151,30 @@ func (f *FindNoteGame) buildAnswer() (map[int]map[int]*music.Note, error) {
 		}
 	}
 
-	targetNotes := make(map[*music.Note]bool)
+	targetNotes := make(map[music.PitchClass]bool)
 
 	for len(targetNotes) < f.NotesAmount {
-		fretIndex := f.rng.Intn(len(f.Fretboard.Strings[0].FretNotes))
+		fretIndex := f.rng.Intn(f.Fretboard.FretCount())
+
+		// the string in itself isn't relevant here as we are trying to get the notes only, as long
+		// as it reaches the fret
+		stringNumber := 1
+		for stringNumber < len(f.Fretboard.Strings) && !f.Fretboard.Strings[stringNumber-1].HasFret(fretIndex) {
+			stringNumber++
+		}
+
+		note, err := f.Fretboard.GetNoteAt(stringNumber, fretIndex)
+		if err != nil {
+			return nil, err
+		}
 
-		// the string in itself isn't relevant here as we are trying to get the notes only
-		note, err := f.Fretboard.GetNoteAt(1, fretIndex)
+		pitchClass, err := note.PitchClass()
 		if err != nil {
 			return nil, err
 		}
 
-		if _, alreadySelected := targetNotes[note]; !alreadySelected {
-			targetNotes[note] = true
+		if _, alreadySelected := targetNotes[pitchClass]; !alreadySelected {
+			targetNotes[pitchClass] = true
 		}
 	}
 
@@ -164,8 +183,11 @@ func (f *FindNoteGame) buildAnswer() (map[int]map[int]*music.Note, error) {
 
 	for stringNumber := range selectedStrings {
 		fretPositionsForString := make(map[int]*music.Note)
-		for note := range targetNotes {
-			fretPositions := f.Fretboard.Strings[stringNumber-1].FindNote(note)
+		for pitchClass := range targetNotes {
+			fretPositions, err := f.Fretboard.FindNote(stringNumber, pitchClass.Note())
+			if err != nil {
+				return nil, err
+			}
 			maps.Copy(fretPositionsForString, fretPositions)
 		}
 		gameAnswer[stringNumber] = fretPositionsForString
@@ -175,27 +197,36 @@ func (f *FindNoteGame) buildAnswer() (map[int]map[int]*music.Note, error) {
 }
 
 func (f *FindNoteGame) displayQuestionForAnswer(correctAnswer map[int]map[int]*music.Note) {
-	uniqueNotes := map[*music.Note]bool{}
+	uniqueNotes := map[music.PitchClass]*music.Note{}
 
 	f.Print("Find note(s) [ ")
 	for _, stringFrets := range correctAnswer {
 		for _, noteAtFret := range stringFrets {
-			if !uniqueNotes[noteAtFret] {
-				uniqueNotes[noteAtFret] = true
+			pitchClass, err := noteAtFret.PitchClass()
+			if err != nil {
+				continue
+			}
+
+			if _, alreadyAdded := uniqueNotes[pitchClass]; !alreadyAdded {
+				uniqueNotes[pitchClass] = noteAtFret
 			}
 		}
 	}
 
-	sortedNotes := slices.Collect(maps.Keys(uniqueNotes))
+	sortedNotes := make([]*music.Note, 0, len(uniqueNotes))
+	for pitchClass, note := range uniqueNotes {
+		if f.Key != nil {
+			note = f.Key.Spell(pitchClass)
+		}
+		sortedNotes = append(sortedNotes, note)
+	}
+
 	sort.Slice(sortedNotes, func(i, j int) bool {
-		return strings.Compare(
-			fmt.Sprintf("%s%s", sortedNotes[i].Name, sortedNotes[i].Symbol),
-			fmt.Sprintf("%s%s", sortedNotes[j].Name, sortedNotes[j].Symbol),
-		) == -1
+		return strings.Compare(sortedNotes[i].String(), sortedNotes[j].String()) == -1
 	})
 
 	for _, note := range sortedNotes {
-		f.Printf("%s%s ", note.Name, note.Symbol)
+		f.Printf("%s ", note.String())
 	}
 
 	f.Print("] across string(s) [ ")
@@ -225,7 +256,7 @@ func (f *FindNoteGame) parseUserAnswer(userInputString string, stringNumber int)
 	}
 
 	for _, fretNumber := range fretNumbersList {
-		note, err := f.Fretboard.GetNoteAt(stringNumber, fretNumber)
+		note, err := f.Fretboard.GetPlayedNoteAt(stringNumber, fretNumber)
 
 		if err != nil {
 			return nil, fmt.Errorf("error note not found at fret number '%d': %v", fretNumber, err)
@@ -267,7 +298,7 @@ func (f *FindNoteGame) verifyAnswer(correctAnswer map[int]map[int]*music.Note, u
 			if !correctNoteFound || !userNoteFound {
 				isAnswerCorrect = false
 			} else {
-				isAnswerCorrect = isAnswerCorrect && correctNote.Equals(userNote)
+				isAnswerCorrect = isAnswerCorrect && correctNote.EnharmonicEquals(userNote)
 			}
 		}
 	}
@@ -297,7 +328,7 @@ func (f *FindNoteGame) displayFretboard(correctAnswer map[int]map[int]*music.Not
 
 			alreadyAdded := false
 			for _, existingNote := range uniqueNotesToHighlight {
-				if existingNote.Equals(noteAtPosition) {
+				if existingNote.EnharmonicEquals(noteAtPosition) {
 					alreadyAdded = true
 				}
 			}
@@ -308,7 +339,12 @@ func (f *FindNoteGame) displayFretboard(correctAnswer map[int]map[int]*music.Not
 		}
 	}
 
-	fretboardVisualization, _ := f.Fretboard.DrawFretboard(uniqueNotesToHighlight, stringsToIgnore)
+	var fretboardVisualization string
+	if f.Key != nil {
+		fretboardVisualization, _ = f.Fretboard.DrawFretboardInKey(uniqueNotesToHighlight, stringsToIgnore, f.Key)
+	} else {
+		fretboardVisualization, _ = f.Fretboard.DrawFretboard(uniqueNotesToHighlight, stringsToIgnore)
+	}
 	f.Println(fretboardVisualization)
 }
 
@@ -3,6 +3,7 @@ package game
 import (
 	"bytes"
 	"github.com/PauloMigAlmeida/fretboard-games/instrument"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
 	"github.com/stretchr/testify/assert"
 	"strings"
 	"testing"
@@ -31,6 +32,27 @@ func TestFindNoteGame_Configure(t *testing.T) {
 
 	err = game.Configure()
 	assert.NotNil(t, err)
+
+	// there are only 12 notes to find, however many frets there are
+	stdin.Reset()
+	stdout.Reset()
+	stdin.WriteString("13\n2\n")
+	game = NewFindNoteGame(fretboard, &stdin, &stdout, NoSeed)
+
+	err = game.Configure()
+	assert.NotNil(t, err)
+}
+
+func TestFindNoteGame_RunStep_WithMoreNotesThanPitchClasses(t *testing.T) {
+	fretboard := instrument.NewFretboard(24, instrument.StandardTuning())
+	var stdin, stdout bytes.Buffer
+
+	game := NewFindNoteGame(fretboard, &stdin, &stdout, 1234)
+	game.NotesAmount = 13
+	game.StringsAmount = 1
+
+	err := game.RunStep()
+	assert.Error(t, err)
 }
 
 func TestFindNoteGame_RunStep_WhenCorrectAnswerIsGiven(t *testing.T) {
@@ -88,15 +110,15 @@ func TestFindNoteGame_RunStep_WithMultipleNotes_WhenCorrectAnswerIsGiven(t *test
 	game.NotesAmount = 3
 	game.StringsAmount = 3
 
-	stdin.WriteString("0,12,4,16,11,23\n")
-	stdin.WriteString("9,21,1,13,8,20\n")
-	stdin.WriteString("7,19,11,23,6,18\n")
+	stdin.WriteString("4,6,11,16,18,23\n")
+	stdin.WriteString("1,3,8,13,15,20\n")
+	stdin.WriteString("4,6,11,16,18,23\n")
 	err := game.RunStep()
 	assert.Nil(t, err)
 
 	buf, _ := game.StdOut.(*bytes.Buffer)
 	bufStr := buf.String()
-	assert.Contains(t, bufStr, "Find note(s) [ D# E G# ] across string(s) [ 1 3 5 ]")
+	assert.Contains(t, bufStr, "Find note(s) [ A# D# G# ] across string(s) [ 1 3 6 ]")
 	assert.Contains(t, bufStr, "Correct! ✅")
 }
 
@@ -108,23 +130,78 @@ func TestFindNoteGame_RunStep_WithMultipleNotes_WhenIncorrectAnswerIsGiven(t *te
 	game.NotesAmount = 3
 	game.StringsAmount = 3
 
-	stdin.WriteString("0,12,4,16,11,22\n")
-	stdin.WriteString("9,21,1,13,8,20\n")
-	stdin.WriteString("7,19,11,23,6,18\n")
+	stdin.WriteString("4,6,11,16,18,22\n")
+	stdin.WriteString("1,3,8,13,15,20\n")
+	stdin.WriteString("4,6,11,16,18,23\n")
 	err := game.RunStep()
 	assert.Nil(t, err)
 
 	buf, _ := game.StdOut.(*bytes.Buffer)
 	bufStr := buf.String()
-	assert.Contains(t, bufStr, "Find note(s) [ D# E G# ] across string(s) [ 1 3 5 ]")
+	assert.Contains(t, bufStr, "Find note(s) [ A# D# G# ] across string(s) [ 1 3 6 ]")
 	assert.Contains(t, bufStr, "Incorrect! ❌")
 	assert.Contains(t, bufStr, strings.TrimSpace(`
 | 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  | 8  | 9  | 10 | 11 | 12 | 13 | 14 | 15 | 16 | 17 | 18 | 19 | 20 | 21 | 22 | 23 |
-| X  | -  | -  | -  | X  | -  | -  | -  | -  | -  | -  | X  | X  | -  | -  | -  | X  | -  | -  | -  | -  | -  | -  | X  |
+| -  | -  | -  | -  | X  | -  | X  | -  | -  | -  | -  | X  | -  | -  | -  | -  | X  | -  | X  | -  | -  | -  | -  | X  |
 | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
-| -  | X  | -  | -  | -  | -  | -  | -  | X  | X  | -  | -  | -  | X  | -  | -  | -  | -  | -  | -  | X  | X  | -  | -  |
+| -  | X  | -  | X  | -  | -  | -  | -  | X  | -  | -  | -  | -  | X  | -  | X  | -  | -  | -  | -  | X  | -  | -  | -  |
 | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
-| -  | -  | -  | -  | -  | -  | X  | X  | -  | -  | -  | X  | -  | -  | -  | -  | -  | -  | X  | X  | -  | -  | -  | X  |
 | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | X  | -  | X  | -  | -  | -  | -  | X  | -  | -  | -  | -  | X  | -  | X  | -  | -  | -  | -  | X  |
 `))
 }
+
+func TestFindNoteGame_RunStep_WithKey(t *testing.T) {
+	fretboard := instrument.NewFretboard(24, instrument.StandardTuning())
+	var stdin, stdout bytes.Buffer
+
+	game := NewFindNoteGame(fretboard, &stdin, &stdout, 1234)
+	game.NotesAmount = 1
+	game.StringsAmount = 1
+	game.Key, _ = music.ParseKey("Bb")
+
+	stdin.WriteString("8,21\n")
+	err := game.RunStep()
+	assert.Nil(t, err)
+
+	buf, _ := game.StdOut.(*bytes.Buffer)
+	bufStr := buf.String()
+	assert.Contains(t, bufStr, "Find note(s) [ Eb ] across string(s) [ 3 ]")
+	assert.Contains(t, bufStr, "Incorrect! ❌")
+	assert.Contains(t, bufStr, "| -  | -  | -  | -  | -  | -  | -  | -  | Eb | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | Eb | -  | -  | -  |")
+}
+
+func TestFindNoteGame_ParseUserAnswer_OnShortString(t *testing.T) {
+	profile, _ := instrument.FindProfile("banjo")
+	var stdin, stdout bytes.Buffer
+
+	game := NewFindNoteGame(profile.NewFretboard(), &stdin, &stdout, NoSeed)
+
+	answer, err := game.parseUserAnswer("5,12", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, music.G, answer[5][5].Name)
+	assert.Equal(t, music.D, answer[5][12].Name)
+
+	// the 5th string of a banjo starts at the 5th fret
+	_, err = game.parseUserAnswer("0", 5)
+	assert.Error(t, err)
+}
+
+func TestFindNoteGame_ParseUserAnswer_WithCapo(t *testing.T) {
+	fretboard := instrument.NewFretboard(24, instrument.StandardTuning())
+	_ = fretboard.SetCapo(instrument.NewCapo(2))
+	fretboard.FretReference = instrument.FretsFromCapo
+	var stdin, stdout bytes.Buffer
+
+	game := NewFindNoteGame(fretboard, &stdin, &stdout, NoSeed)
+
+	// the capo is fret 0, so the low E string sounds F# there
+	answer, err := game.parseUserAnswer("0,1", 6)
+	assert.Nil(t, err)
+	assert.Equal(t, music.F, answer[6][0].Name)
+	assert.Equal(t, music.Sharp, answer[6][0].Symbol)
+	assert.Equal(t, music.G, answer[6][1].Name)
+
+	_, err = game.parseUserAnswer("-1", 6)
+	assert.Error(t, err)
+}
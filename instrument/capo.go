@@ -0,0 +1,88 @@
+package instrument
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FretReference says where fret numbers given to or reported by games are counted from once there
+// is a capo on the fretboard.
+type FretReference int
+
+const (
+	// FretsFromNut keeps counting frets from the nut, so with a capo on the 2nd fret the lowest
+	// playable fret is 2
+	FretsFromNut FretReference = iota
+	// FretsFromCapo counts frets of capoed strings from the capo, so the capo itself is fret 0.
+	// Strings a partial capo leaves out are still counted from the nut.
+	FretsFromCapo
+)
+
+type Capo struct {
+	Fret int
+	// Strings covered by a partial capo, or empty when the capo covers every string
+	Strings []int
+}
+
+func NewCapo(fret int) *Capo {
+	return &Capo{Fret: fret}
+}
+
+// NewPartialCapo returns a capo that only clamps some of the strings, such as strings 1 to 5 on
+// fret 2, which leaves the low E open to sound like drop D a whole step up.
+func NewPartialCapo(fret int, stringNumbers []int) *Capo {
+	return &Capo{
+		Fret:    fret,
+		Strings: slices.Sorted(slices.Values(stringNumbers)),
+	}
+}
+
+// ParseCapo parses a fret number (e.g. "2") for a full capo, or a fret number and the strings
+// it covers (e.g. "2:3,4,5") for a partial capo.
+func ParseCapo(capo string) (*Capo, error) {
+	fretPart, stringsPart, partial := strings.Cut(capo, ":")
+
+	fret, err := strconv.Atoi(strings.TrimSpace(fretPart))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing capo fret '%s': %v", fretPart, err)
+	}
+
+	if !partial {
+		return NewCapo(fret), nil
+	}
+
+	stringNumbers := make([]int, 0)
+	for _, token := range strings.Split(stringsPart, ",") {
+		stringNumber, err := strconv.Atoi(strings.TrimSpace(token))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing capo string '%s': %v", token, err)
+		}
+		stringNumbers = append(stringNumbers, stringNumber)
+	}
+
+	return NewPartialCapo(fret, stringNumbers), nil
+}
+
+func (c *Capo) IsPartial() bool {
+	return len(c.Strings) > 0
+}
+
+// Covers tells whether the capo clamps the given string.
+func (c *Capo) Covers(stringNumber int) bool {
+	return !c.IsPartial() || slices.Contains(c.Strings, stringNumber)
+}
+
+func (c *Capo) String() string {
+	if !c.IsPartial() {
+		return fmt.Sprintf("capo at fret %d", c.Fret)
+	}
+
+	stringNumbers := make([]string, len(c.Strings))
+	for i, stringNumber := range c.Strings {
+		stringNumbers[i] = strconv.Itoa(stringNumber)
+	}
+
+	return fmt.Sprintf("partial capo at fret %d on strings %s", c.Fret, strings.Join(stringNumbers, ", "))
+}
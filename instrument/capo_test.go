@@ -0,0 +1,126 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestCapo_ParseCapo(t *testing.T) {
+	capo, err := ParseCapo("2")
+	assert.Nil(t, err)
+	assert.False(t, capo.IsPartial())
+	assert.True(t, capo.Covers(6))
+	assert.Equal(t, "capo at fret 2", capo.String())
+
+	capo, err = ParseCapo("2:5, 3,4")
+	assert.Nil(t, err)
+	assert.True(t, capo.IsPartial())
+	assert.True(t, capo.Covers(3))
+	assert.False(t, capo.Covers(6))
+	assert.Equal(t, "partial capo at fret 2 on strings 3, 4, 5", capo.String())
+
+	_, err = ParseCapo("two")
+	assert.Error(t, err)
+
+	_, err = ParseCapo("2:3,x")
+	assert.Error(t, err)
+}
+
+func TestFretboard_SetCapo(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	assert.Error(t, fretboard.SetCapo(NewCapo(0)))
+	assert.Error(t, fretboard.SetCapo(NewCapo(24)))
+	assert.Error(t, fretboard.SetCapo(NewPartialCapo(2, []int{5, 7})))
+	assert.Nil(t, fretboard.Capo)
+
+	assert.Nil(t, fretboard.SetCapo(NewCapo(2)))
+	assert.NotNil(t, fretboard.Capo)
+
+	assert.Nil(t, fretboard.SetCapo(nil))
+	assert.Nil(t, fretboard.Capo)
+}
+
+func TestFretboard_FindNote_WithCapo(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+	eNote, _ := music.FindNote(music.E, music.Natural)
+	fNote, _ := music.FindNote(music.F, music.Natural)
+
+	_ = fretboard.SetCapo(NewCapo(3))
+
+	// the open E is behind the capo
+	positions, err := fretboard.FindNote(6, eNote)
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]*music.Note{12: eNote}, positions)
+
+	fretboard.FretReference = FretsFromCapo
+	positions, _ = fretboard.FindNote(6, eNote)
+	assert.Equal(t, map[int]*music.Note{9: eNote}, positions)
+
+	note, err := fretboard.GetPlayedNoteAt(6, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, music.G, note.Name)
+
+	_, err = fretboard.GetPlayedNoteAt(6, -1)
+	assert.Error(t, err)
+
+	// a partial capo leaves the other strings counted from the nut
+	_ = fretboard.SetCapo(NewPartialCapo(1, []int{1, 2, 3, 4, 5}))
+	positions, _ = fretboard.FindNote(6, fNote)
+	assert.Equal(t, map[int]*music.Note{1: fNote, 13: fNote}, positions)
+	positions, _ = fretboard.FindNote(1, fNote)
+	assert.Equal(t, map[int]*music.Note{0: fNote, 12: fNote}, positions)
+
+	fretboard.FretReference = FretsFromNut
+	note, err = fretboard.GetPlayedNoteAt(6, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, music.E, note.Name)
+
+	_, err = fretboard.GetPlayedNoteAt(5, 0)
+	assert.Error(t, err)
+
+	// a capo below the short string of a banjo doesn't clamp it
+	profile, _ := FindProfile("banjo")
+	banjo := profile.NewFretboard()
+	_ = banjo.SetCapo(NewCapo(2))
+	gNote, _ := music.FindNote(music.G, music.Natural)
+	positions, _ = banjo.FindNote(5, gNote)
+	assert.Equal(t, map[int]*music.Note{5: gNote, 17: gNote}, positions)
+}
+
+func TestFretboard_DrawFretboard_WithCapo(t *testing.T) {
+	fretboard := NewFretboard(8, StandardTuning())
+	noteA, _ := music.FindNote(music.A, music.Natural)
+
+	_ = fretboard.SetCapo(NewPartialCapo(2, []int{3, 4, 5}))
+	ret, err := fretboard.DrawFretboard([]*music.Note{noteA}, []int{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+| 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  |
+| -  | -  | -  | -  | -  | X  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  |
+          ] X  | -  | -  | -  | -  | -  |
+          ] -  | -  | -  | -  | -  | X  |
+          ] -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | X  | -  | -  |
+`), strings.TrimSpace(ret))
+
+	// a full capo with frets counted from it hides the frets behind it
+	_ = fretboard.SetCapo(NewCapo(2))
+	fretboard.FretReference = FretsFromCapo
+	ret, err = fretboard.DrawFretboard([]*music.Note{noteA}, []int{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+| 0  | 1  | 2  | 3  | 4  | 5  |
+] -  | -  | -  | X  | -  | -  |
+] -  | -  | -  | -  | -  | -  |
+] X  | -  | -  | -  | -  | -  |
+] -  | -  | -  | -  | -  | X  |
+] -  | -  | -  | -  | -  | -  |
+] -  | -  | -  | X  | -  | -  |
+`), ret)
+}
@@ -5,76 +5,387 @@ import (
 	"github.com/PauloMigAlmeida/fretboard-games/music"
 	"slices"
 	"strings"
+	"unicode/utf8"
 )
 
 type Fretboard struct {
 	Strings []*String
+	Tuning  *Tuning
+	// Capo is optional, see SetCapo
+	Capo *Capo
+	// FretReference is how FindNote and GetPlayedNoteAt number frets when there is a capo
+	FretReference FretReference
+	// Inlays are the frets with position markers, drawn under the strings when there are any
+	Inlays []int
+	// ScaleLength is the length of the vibrating strings in inches, or 0 when unknown
+	ScaleLength float64
+	// Multiscale is only set for fanned-fret boards, where it replaces ScaleLength
+	Multiscale *Multiscale
 }
 
-func NewFretboard(numOfFrets int, tuning []*music.Note) *Fretboard {
-	fretStrings := make([]*String, len(tuning))
+func NewFretboard(numOfFrets int, tuning *Tuning) *Fretboard {
+	fretStrings := make([]*String, tuning.StringCount())
 
-	for i := range len(fretStrings) {
-		fretStrings[i] = NewString(tuning[i], numOfFrets)
+	for i, pitch := range tuning.Pitches {
+		fretStrings[i] = NewPitchedString(pitch, 0, numOfFrets)
 	}
 
 	return &Fretboard{
 		Strings: fretStrings,
+		Tuning:  tuning,
 	}
 }
 
 func (f *Fretboard) GetNoteAt(stringNumber int, fretNumber int) (*music.Note, error) {
+	if err := f.checkStringNumber(stringNumber); err != nil {
+		return nil, err
+	}
+
+	// strings are 1-indexed
+	note, err := f.Strings[stringNumber-1].NoteAt(fretNumber)
+	if err != nil {
+		return nil, fmt.Errorf("string '%d': %v", stringNumber, err)
+	}
+
+	return note, nil
+}
+
+// PitchAt works like GetNoteAt but returns the exact pitch, including its octave.
+func (f *Fretboard) PitchAt(stringNumber int, fretNumber int) (*music.Pitch, error) {
+	if err := f.checkStringNumber(stringNumber); err != nil {
+		return nil, err
+	}
+
+	pitch, err := f.Strings[stringNumber-1].PitchAt(fretNumber)
+	if err != nil {
+		return nil, fmt.Errorf("string '%d': %v", stringNumber, err)
+	}
+
+	return pitch, nil
+}
+
+// GetPlayedNoteAt works like GetNoteAt, except that the fret number follows the fretboard's
+// FretReference and frets behind the capo can't be played.
+func (f *Fretboard) GetPlayedNoteAt(stringNumber int, fretNumber int) (*music.Note, error) {
+	if err := f.checkStringNumber(stringNumber); err != nil {
+		return nil, err
+	}
+
+	nutFretNumber := fretNumber + f.fretOffset(stringNumber)
+	if f.isCapoed(stringNumber) && nutFretNumber < f.Capo.Fret {
+		return nil, fmt.Errorf("fret '%d' is behind the capo on string '%d'", fretNumber, stringNumber)
+	}
+
+	return f.GetNoteAt(stringNumber, nutFretNumber)
+}
+
+// FindNote returns the frets a note can be played at on a string, leaving out the frets behind
+// the capo. Fret numbers follow the fretboard's FretReference.
+func (f *Fretboard) FindNote(stringNumber int, note *music.Note) (map[int]*music.Note, error) {
+	if err := f.checkStringNumber(stringNumber); err != nil {
+		return nil, err
+	}
+
+	ret := make(map[int]*music.Note)
+	for fretNumber, foundNote := range f.Strings[stringNumber-1].FindNote(note) {
+		if fretNumber >= f.lowestFret(stringNumber) {
+			ret[fretNumber-f.fretOffset(stringNumber)] = foundNote
+		}
+	}
+
+	return ret, nil
+}
+
+// FindPitch returns every position the exact pitch can be played at (e.g. E3 but not E2 or E4),
+// however it's spelled (Fb3 is found where E3 is), from the lowest string to the highest one. Frets behind the capo are left out and fret numbers
+// are counted from the nut.
+func (f *Fretboard) FindPitch(pitch *music.Pitch) []Position {
+	return f.findPositions(func(candidate *music.Pitch) bool {
+		return candidate.EnharmonicEquals(pitch)
+	})
+}
+
+// Unisons returns the other positions that sound exactly the same pitch as the given one, such
+// as string 2 fret 0 for string 3 fret 4 on a guitar in standard tuning.
+func (f *Fretboard) Unisons(position Position) ([]Position, error) {
+	pitch, err := f.PitchAt(position.StringNumber, position.FretNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(f.FindPitch(pitch), func(candidate Position) bool {
+		return candidate == position
+	}), nil
+}
+
+// OctaveShapes returns the positions that sound one or more octaves above or below the given
+// one without moving the hand more than maxFretSpan frets away from it, which are the octave
+// shapes guitarists use to find the same note across the neck.
+func (f *Fretboard) OctaveShapes(position Position, maxFretSpan int) ([]Position, error) {
+	pitch, err := f.PitchAt(position.StringNumber, position.FretNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := f.findPositions(func(candidate *music.Pitch) bool {
+		distance := candidate.MIDI() - pitch.MIDI()
+		return distance != 0 && distance%music.PitchClassCount == 0
+	})
+
+	return slices.DeleteFunc(positions, func(candidate Position) bool {
+		return max(candidate.FretNumber-position.FretNumber, position.FretNumber-candidate.FretNumber) > maxFretSpan
+	}), nil
+}
+
+func (f *Fretboard) findPositions(matches func(pitch *music.Pitch) bool) []Position {
+	positions := make([]Position, 0)
+
+	for strIdx := len(f.Strings) - 1; strIdx >= 0; strIdx-- {
+		str := f.Strings[strIdx]
+		for fretNumber := f.lowestFret(strIdx + 1); fretNumber <= str.LastFret(); fretNumber++ {
+			pitch, err := str.PitchAt(fretNumber)
+			if err == nil && matches(pitch) {
+				positions = append(positions, Position{StringNumber: strIdx + 1, FretNumber: fretNumber})
+			}
+		}
+	}
+
+	return positions
+}
+
+// SetCapo puts a capo on the fretboard, or takes it off when capo is nil. A capo placed below
+// the start of a short string (e.g. the 5th string of a banjo) leaves that string open.
+func (f *Fretboard) SetCapo(capo *Capo) error {
+	if capo == nil {
+		f.Capo = nil
+		return nil
+	}
+
+	if capo.Fret < 1 || capo.Fret > f.FretCount()-1 {
+		return fmt.Errorf("capo can't be placed on fret '%d', it has to be between '1' and '%d'", capo.Fret, f.FretCount()-1)
+	}
+
+	for _, stringNumber := range capo.Strings {
+		if err := f.checkStringNumber(stringNumber); err != nil {
+			return fmt.Errorf("capo can't cover string '%d': %v", stringNumber, err)
+		}
+	}
+
+	f.Capo = capo
+	return nil
+}
+
+// FretCount returns the number of fret positions on the fretboard, from the nut up to the highest
+// fret of any string.
+func (f *Fretboard) FretCount() int {
+	fretCount := 0
+	for _, str := range f.Strings {
+		fretCount = max(fretCount, str.LastFret()+1)
+	}
+
+	return fretCount
+}
+
+func (f *Fretboard) checkStringNumber(stringNumber int) error {
 	if stringNumber < 1 {
-		return nil, fmt.Errorf("strings are 1-indexed to be like how we number them in real world")
+		return fmt.Errorf("strings are 1-indexed to be like how we number them in real world")
 	}
 
 	if stringNumber > len(f.Strings) {
-		return nil, fmt.Errorf("string '%d' doesn't exist", stringNumber)
+		return fmt.Errorf("string '%d' doesn't exist", stringNumber)
 	}
 
-	if fretNumber < 0 || fretNumber > len(f.Strings[0].FretNotes)-1 {
-		return nil, fmt.Errorf("fret '%d' doesn't exist", stringNumber)
+	return nil
+}
+
+func (f *Fretboard) isCapoed(stringNumber int) bool {
+	return f.Capo != nil && f.Capo.Covers(stringNumber) && f.Capo.Fret > f.Strings[stringNumber-1].StartFret
+}
+
+// lowestFret returns the lowest fret that can be played on a string, counted from the nut.
+func (f *Fretboard) lowestFret(stringNumber int) int {
+	if f.isCapoed(stringNumber) {
+		return f.Capo.Fret
 	}
 
-	// strings are 1-indexed
-	return &f.Strings[stringNumber-1].FretNotes[fretNumber], nil
+	return f.Strings[stringNumber-1].StartFret
+}
+
+// openPitch returns the pitch a string sounds at its own nut, which is only known for strings
+// built from a pitch (see NewPitchedString).
+func (f *Fretboard) openPitch(stringNumber int) (*music.Pitch, error) {
+	str := f.Strings[stringNumber-1]
+	if len(str.FretPitches) == 0 {
+		return nil, fmt.Errorf("string '%d' wasn't built from a pitch, so only its notes are known", stringNumber)
+	}
+
+	pitch := str.FretPitches[0]
+	return &pitch, nil
+}
+
+// fretOffset is what has to be added to a fret number given in the fretboard's FretReference to
+// count it from the nut.
+func (f *Fretboard) fretOffset(stringNumber int) int {
+	if f.FretReference == FretsFromCapo && f.isCapoed(stringNumber) {
+		return f.Capo.Fret
+	}
+
+	return 0
+}
+
+// IdentifyChord names the chord played on the given positions. The note on the lowest pitched
+// string (i.e. the highest string number) is taken as the bass note. Fret numbers follow the
+// fretboard's FretReference, like for GetPlayedNoteAt, and frets behind the capo can't be played.
+func (f *Fretboard) IdentifyChord(positions []Position) ([]music.ChordCandidate, error) {
+	sortedPositions := slices.Clone(positions)
+	slices.SortFunc(sortedPositions, func(a, b Position) int {
+		return b.StringNumber - a.StringNumber
+	})
+
+	notes := make([]*music.Note, 0, len(sortedPositions))
+	for i, position := range sortedPositions {
+		if i > 0 && sortedPositions[i-1].StringNumber == position.StringNumber {
+			return nil, fmt.Errorf("string '%d' can't play more than one note at a time", position.StringNumber)
+		}
+
+		note, err := f.GetPlayedNoteAt(position.StringNumber, position.FretNumber)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	return music.IdentifyChord(notes)
+}
+
+// ArpeggioPositions finds where to play each pitch of an arpeggio without leaving the frets
+// between lowestFret and highestFret, preferring lower strings when a pitch can be played in more
+// than one place. Frets behind the capo are skipped.
+func (f *Fretboard) ArpeggioPositions(pitches []*music.Pitch, lowestFret int, highestFret int) ([]Position, error) {
+	if lowestFret < 0 || highestFret > f.FretCount()-1 || lowestFret > highestFret {
+		return nil, fmt.Errorf("frets '%d' to '%d' aren't a valid window on this fretboard", lowestFret, highestFret)
+	}
+
+	positions := make([]Position, 0, len(pitches))
+	for _, pitch := range pitches {
+		found := false
+		for strIdx := len(f.Strings) - 1; strIdx >= 0 && !found; strIdx-- {
+			openPitch, err := f.openPitch(strIdx + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			fretNumber := pitch.MIDI() - openPitch.MIDI() + f.Strings[strIdx].StartFret
+			if fretNumber < f.lowestFret(strIdx+1) {
+				continue
+			}
+
+			if fretNumber >= lowestFret && fretNumber <= highestFret && f.Strings[strIdx].HasFret(fretNumber) {
+				positions = append(positions, Position{StringNumber: strIdx + 1, FretNumber: fretNumber})
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("'%s' can't be played between frets '%d' and '%d'", pitch.String(), lowestFret, highestFret)
+		}
+	}
+
+	return positions, nil
 }
 
 func (f *Fretboard) DrawFretboard(notes []*music.Note, ignoreStrings []int) (string, error) {
+	return f.drawFretboard(notes, ignoreStrings, func(note *music.Note) string {
+		return "X"
+	})
+}
+
+// DrawFretboardInKey works like DrawFretboard but prints the name of each highlighted note,
+// spelled as it would be written in the given key.
+func (f *Fretboard) DrawFretboardInKey(notes []*music.Note, ignoreStrings []int, key *music.Key) (string, error) {
+	return f.drawFretboard(notes, ignoreStrings, func(note *music.Note) string {
+		pitchClass, err := note.PitchClass()
+		if err != nil {
+			return note.String()
+		}
+		return key.Spell(pitchClass).String()
+	})
+}
+
+func (f *Fretboard) drawFretboard(notes []*music.Note, ignoreStrings []int, label func(note *music.Note) string) (string, error) {
+	// cells grow to fit long note names (e.g. "Heses" or "Sol#")
+	cellWidth := 3
+	for _, note := range notes {
+		cellWidth = max(cellWidth, utf8.RuneCountInString(label(note)))
+	}
+
+	return f.drawCells(cellWidth, func(position Position, note *music.Note) string {
+		if slices.Contains(ignoreStrings, position.StringNumber) {
+			return "-"
+		}
+
+		for _, n := range notes {
+			if n.EnharmonicEquals(note) {
+				return label(note)
+			}
+		}
+
+		return "-"
+	})
+}
+
+// drawCells draws the fretboard with the text returned by cell for every fret of every string.
+func (f *Fretboard) drawCells(cellWidth int, cell func(position Position, note *music.Note) string) (string, error) {
 	var sb strings.Builder
 
-	if len(f.Strings) == 0 || len(f.Strings[0].FretNotes) == 0 {
+	fretCount := f.FretCount()
+	if len(f.Strings) == 0 || fretCount == 0 {
 		return "", fmt.Errorf("fretboard has no strings or frets")
 	}
 
+	// with a full capo and frets counted from it, the frets behind the capo aren't drawn at all
+	firstFret := 0
+	if f.Capo != nil && !f.Capo.IsPartial() && f.FretReference == FretsFromCapo {
+		firstFret = f.Capo.Fret
+	}
+
 	// Header
 	sb.WriteString("|")
-	for idx := range f.Strings[0].FretNotes {
-		sb.WriteString(fmt.Sprintf(" %-3d|", idx))
+	for idx := firstFret; idx < fretCount; idx++ {
+		sb.WriteString(fmt.Sprintf(" %-*d|", cellWidth, idx-firstFret))
 	}
 	sb.WriteString("\n")
 
 	// Body
 	for strIdx, strEl := range f.Strings {
-		sb.WriteString("|")
-		for _, note := range strEl.FretNotes {
-
-			if slices.Contains(ignoreStrings, strIdx+1) {
-				sb.WriteString(fmt.Sprintf(" %-3s", "-"))
-			} else {
-				found := false
-				for _, n := range notes {
-					if n.Equals(&note) {
-						sb.WriteString(fmt.Sprintf(" %-3s", "X"))
-						found = true
-					}
-				}
+		// frets a string doesn't reach are left blank, with its nut ("|") or the capo ("]") drawn
+		// where it starts
+		lowestFret := f.lowestFret(strIdx + 1)
+		nut := "|"
+		if f.isCapoed(strIdx + 1) {
+			nut = "]"
+		}
 
-				if !found {
-					sb.WriteString(fmt.Sprintf(" %-3s", "-"))
+		if lowestFret == firstFret {
+			sb.WriteString(nut)
+		} else {
+			sb.WriteString(" ")
+		}
+
+		for fretNumber := firstFret; fretNumber < fretCount; fretNumber++ {
+			if fretNumber < lowestFret || !strEl.HasFret(fretNumber) {
+				sb.WriteString(fmt.Sprintf(" %-*s", cellWidth, ""))
+				if fretNumber == lowestFret-1 {
+					sb.WriteString(nut)
+				} else {
+					sb.WriteString(" ")
 				}
+				continue
 			}
 
+			note := strEl.FretNotes[fretNumber-strEl.StartFret]
+			position := Position{StringNumber: strIdx + 1, FretNumber: fretNumber}
+			sb.WriteString(fmt.Sprintf(" %-*s", cellWidth, cell(position, &note)))
 			sb.WriteString("|")
 		}
 
@@ -83,5 +394,22 @@ func (f *Fretboard) DrawFretboard(notes []*music.Note, ignoreStrings []int) (str
 		}
 	}
 
+	// Inlays, doubled on every octave
+	if len(f.Inlays) > 0 {
+		var inlays strings.Builder
+		inlays.WriteString(" ")
+		for fretNumber := firstFret; fretNumber < fretCount; fretNumber++ {
+			marker := ""
+			if slices.Contains(f.Inlays, fretNumber) {
+				marker = "*"
+				if fretNumber%music.PitchClassCount == 0 {
+					marker = "**"
+				}
+			}
+			inlays.WriteString(fmt.Sprintf(" %-*s ", cellWidth, marker))
+		}
+		sb.WriteString("\n" + strings.TrimRight(inlays.String(), " "))
+	}
+
 	return sb.String(), nil
 }
@@ -116,3 +116,333 @@ func TestFretboard_DrawFretboard(t *testing.T) {
 | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
 `), ret)
 }
+
+func TestFretboard_IdentifyChord(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	// open C major chord (x32010)
+	candidates, err := fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 0},
+		{StringNumber: 2, FretNumber: 1},
+		{StringNumber: 3, FretNumber: 0},
+		{StringNumber: 4, FretNumber: 2},
+		{StringNumber: 5, FretNumber: 3},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "C", candidates[0].Name)
+
+	// same chord with the E in the bass (032010)
+	candidates, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 6, FretNumber: 0},
+		{StringNumber: 5, FretNumber: 3},
+		{StringNumber: 4, FretNumber: 2},
+		{StringNumber: 3, FretNumber: 0},
+		{StringNumber: 2, FretNumber: 1},
+		{StringNumber: 1, FretNumber: 0},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "C/E", candidates[0].Name)
+
+	// two notes on the same string
+	_, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 0},
+		{StringNumber: 1, FretNumber: 3},
+	})
+	assert.Error(t, err)
+
+	// invalid position
+	_, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 0},
+		{StringNumber: 7, FretNumber: 3},
+	})
+	assert.Error(t, err)
+
+	// the C shape with a capo at fret 2 sounds a D chord
+	assert.Nil(t, fretboard.SetCapo(NewCapo(2)))
+	candidates, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 2},
+		{StringNumber: 2, FretNumber: 3},
+		{StringNumber: 3, FretNumber: 2},
+		{StringNumber: 4, FretNumber: 4},
+		{StringNumber: 5, FretNumber: 5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "D", candidates[0].Name)
+
+	// open strings are behind the capo
+	_, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 0},
+		{StringNumber: 2, FretNumber: 3},
+		{StringNumber: 3, FretNumber: 2},
+	})
+	assert.Error(t, err)
+
+	// and fret numbers can be counted from the capo
+	fretboard.FretReference = FretsFromCapo
+	candidates, err = fretboard.IdentifyChord([]Position{
+		{StringNumber: 1, FretNumber: 0},
+		{StringNumber: 2, FretNumber: 1},
+		{StringNumber: 3, FretNumber: 0},
+		{StringNumber: 4, FretNumber: 2},
+		{StringNumber: 5, FretNumber: 3},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "D", candidates[0].Name)
+}
+
+func TestFretboard_DrawFretboardInKey(t *testing.T) {
+	fretboard := NewFretboard(12, StandardTuning())
+
+	noteASharp, _ := music.FindNote(music.A, music.Sharp)
+	key, _ := music.ParseKey("F")
+
+	ret, err := fretboard.DrawFretboardInKey([]*music.Note{
+		noteASharp,
+	}, []int{
+		6, 5, 4, 3,
+	}, key)
+	assert.Nil(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+| 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  | 8  | 9  | 10 | 11 |
+| -  | -  | -  | -  | -  | -  | Bb | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | Bb |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+`), ret)
+}
+
+func TestFretboard_DrawFretboardInKey_NamingSystem(t *testing.T) {
+	defer music.SetDefaultNamingSystem(music.EnglishNaming)
+	music.SetDefaultNamingSystem(music.SolfegeNaming)
+
+	fretboard := NewFretboard(6, StandardTuning())
+
+	noteGSharp, _ := music.FindNote(music.G, music.Sharp)
+	key, _ := music.ParseKey("La")
+
+	ret, err := fretboard.DrawFretboardInKey([]*music.Note{
+		noteGSharp,
+	}, []int{
+		6, 5, 4, 2, 1,
+	}, key)
+	assert.Nil(t, err)
+
+	// cells grow to fit the longest note name
+	assert.Equal(t, strings.TrimSpace(`
+| 0   | 1   | 2   | 3   | 4   | 5   |
+| -   | -   | -   | -   | -   | -   |
+| -   | -   | -   | -   | -   | -   |
+| -   | Sol#| -   | -   | -   | -   |
+| -   | -   | -   | -   | -   | -   |
+| -   | -   | -   | -   | -   | -   |
+| -   | -   | -   | -   | -   | -   |
+`), ret)
+}
+
+func TestFretboard_ArpeggioPositions(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	chord, _ := music.ParseChord("C")
+	arpeggio, _ := chord.Arpeggio(music.ArpeggioOptions{StartOctave: 3})
+
+	positions, err := fretboard.ArpeggioPositions(arpeggio, 2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []Position{
+		{StringNumber: 5, FretNumber: 3},
+		{StringNumber: 4, FretNumber: 2},
+		{StringNumber: 4, FretNumber: 5},
+		{StringNumber: 3, FretNumber: 5},
+	}, positions)
+
+	// the same position works backwards
+	arpeggio, _ = chord.Arpeggio(music.ArpeggioOptions{StartOctave: 3, Direction: music.ArpeggioDown})
+	positions, err = fretboard.ArpeggioPositions(arpeggio, 2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, Position{StringNumber: 3, FretNumber: 5}, positions[0])
+	assert.Equal(t, Position{StringNumber: 5, FretNumber: 3}, positions[3])
+
+	// two octaves don't fit in this window
+	arpeggio, _ = chord.Arpeggio(music.ArpeggioOptions{StartOctave: 3, Octaves: 2})
+	_, err = fretboard.ArpeggioPositions(arpeggio, 2, 5)
+	assert.Error(t, err)
+
+	// invalid windows
+	_, err = fretboard.ArpeggioPositions(arpeggio, 5, 2)
+	assert.Error(t, err)
+
+	_, err = fretboard.ArpeggioPositions(arpeggio, 0, 24)
+	assert.Error(t, err)
+}
+
+func TestFretboard_ArpeggioPositions_WithCapo(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	chord, _ := music.ParseChord("G")
+	arpeggio, _ := chord.Arpeggio(music.ArpeggioOptions{StartOctave: 3})
+
+	positions, err := fretboard.ArpeggioPositions(arpeggio, 0, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, Position{StringNumber: 3, FretNumber: 4}, positions[1])
+
+	// B3 is behind the capo on the G string, so it moves to the open B string
+	assert.Nil(t, fretboard.SetCapo(NewPartialCapo(5, []int{3, 4, 5, 6})))
+	positions, err = fretboard.ArpeggioPositions(arpeggio, 0, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []Position{
+		{StringNumber: 4, FretNumber: 5},
+		{StringNumber: 2, FretNumber: 0},
+		{StringNumber: 2, FretNumber: 3},
+		{StringNumber: 1, FretNumber: 3},
+	}, positions)
+
+	// E3 is only on the D string within these frets, behind the capo
+	assert.Nil(t, fretboard.SetCapo(NewCapo(3)))
+	chord, _ = music.ParseChord("C")
+	arpeggio, _ = chord.Arpeggio(music.ArpeggioOptions{StartOctave: 3})
+	_, err = fretboard.ArpeggioPositions(arpeggio, 0, 5)
+	assert.Error(t, err)
+}
+
+func TestFretboard_ShortString(t *testing.T) {
+	profile, _ := FindProfile("banjo")
+	profile.Frets = 8
+	fretboard := profile.NewFretboard()
+
+	assert.Equal(t, 9, fretboard.FretCount())
+
+	_, err := fretboard.GetNoteAt(5, 4)
+	assert.Error(t, err)
+
+	note, err := fretboard.GetNoteAt(5, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, music.G, note.Name)
+
+	note, err = fretboard.GetNoteAt(5, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, music.A, note.Name)
+	assert.Equal(t, music.Sharp, note.Symbol)
+
+	noteG, _ := music.FindNote(music.G, music.Natural)
+	ret, err := fretboard.DrawFretboard([]*music.Note{noteG}, []int{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+| 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  | 8  |
+| -  | -  | -  | -  | -  | X  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | X  |
+| X  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | X  | -  | -  | -  |
+                         | X  | -  | -  | -  |
+                 *         *         *
+`), ret)
+
+	// lower strings are preferred, so G4 is played on the open short string rather than string 1
+	g4, _ := music.ParsePitch("G4")
+	positions, err := fretboard.ArpeggioPositions([]*music.Pitch{g4}, 5, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, []Position{{StringNumber: 5, FretNumber: 5}}, positions)
+}
+
+func TestFretboard_FindPitch(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	e3, _ := music.ParsePitch("E3")
+	assert.Equal(t, []Position{
+		{StringNumber: 6, FretNumber: 12},
+		{StringNumber: 5, FretNumber: 7},
+		{StringNumber: 4, FretNumber: 2},
+	}, fretboard.FindPitch(e3))
+
+	fFlat3, _ := music.ParsePitch("Fb3")
+	assert.Equal(t, fretboard.FindPitch(e3), fretboard.FindPitch(fFlat3))
+
+	pitch, err := fretboard.PitchAt(1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "E4", pitch.String())
+
+	_, err = fretboard.PitchAt(7, 0)
+	assert.Error(t, err)
+
+	// positions behind the capo can't be played
+	_ = fretboard.SetCapo(NewCapo(3))
+	assert.Equal(t, []Position{
+		{StringNumber: 6, FretNumber: 12},
+		{StringNumber: 5, FretNumber: 7},
+	}, fretboard.FindPitch(e3))
+}
+
+func TestFretboard_Unisons(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	unisons, err := fretboard.Unisons(Position{StringNumber: 3, FretNumber: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, []Position{
+		{StringNumber: 6, FretNumber: 20},
+		{StringNumber: 5, FretNumber: 15},
+		{StringNumber: 4, FretNumber: 10},
+		{StringNumber: 2, FretNumber: 1},
+	}, unisons)
+
+	_, err = fretboard.Unisons(Position{StringNumber: 3, FretNumber: 30})
+	assert.Error(t, err)
+}
+
+func TestFretboard_OctaveShapes(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	shapes, err := fretboard.OctaveShapes(Position{StringNumber: 6, FretNumber: 3}, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []Position{
+		{StringNumber: 4, FretNumber: 5},
+		{StringNumber: 1, FretNumber: 3},
+	}, shapes)
+
+	// octave shapes crossing the B string reach one fret further than the others
+	shapes, _ = fretboard.OctaveShapes(Position{StringNumber: 4, FretNumber: 5}, 3)
+	assert.Equal(t, []Position{
+		{StringNumber: 6, FretNumber: 3},
+		{StringNumber: 2, FretNumber: 8},
+		{StringNumber: 1, FretNumber: 3},
+	}, shapes)
+}
+
+func TestFretboard_DrawFretboard_WithInlays(t *testing.T) {
+	fretboard := NewFretboard(13, StandardTuning())
+	fretboard.Inlays = []int{3, 5, 7, 9, 12}
+
+	ret, err := fretboard.DrawFretboard([]*music.Note{}, []int{1, 2, 3, 4, 5})
+	assert.Nil(t, err)
+
+	assert.Equal(t, `| 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  | 8  | 9  | 10 | 11 | 12 |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+| -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  | -  |
+                 *         *         *         *              **`, ret)
+}
+
+func TestFretboard_Unpitched(t *testing.T) {
+	// strings built from notes only know their pitch classes, not their octaves
+	fretboard := &Fretboard{ScaleLength: 25.5}
+	for _, name := range []string{"E", "B", "G", "D", "A", "E"} {
+		note, _ := music.ParseNote(name)
+		fretboard.Strings = append(fretboard.Strings, NewString(note, 13))
+	}
+
+	chord, _ := music.ParseChord("C")
+	_, err := fretboard.ChordVoicings(chord, VoicingOptions{})
+	assert.Error(t, err)
+
+	c4, _ := music.ParsePitch("C4")
+	_, err = fretboard.ArpeggioPositions([]*music.Pitch{c4}, 0, 5)
+	assert.Error(t, err)
+
+	gauges, _ := ParseStringGauges("regular light")
+	_, err = fretboard.Tensions(gauges, TensionLimits{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,140 @@
+package instrument
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"math"
+)
+
+// FretPosition is where a fret sits on a string. Distances use the same unit as the scale length
+// they were worked out from (e.g. inches or millimetres).
+type FretPosition struct {
+	Fret int
+	// FromNut is the distance along the string from the nut of the fretboard to the fret, even for
+	// strings that start above the nut (e.g. the 5th string of a banjo)
+	FromNut float64
+	// FromPreviousFret is the distance from the fret before it (or from the nut for fret 1)
+	FromPreviousFret float64
+	// FromPerpendicular is how far along the neck the fret is from the perpendicular fret, which is
+	// the nut on boards that aren't multiscale. Frets closer to the nut than the perpendicular
+	// fret have negative distances.
+	FromPerpendicular float64
+}
+
+// Multiscale describes a fanned-fret board, where the lowest string (the bass side) is longer
+// than the highest one (the treble side) and the strings in between are spread evenly. Only the
+// perpendicular fret is square to the neck.
+type Multiscale struct {
+	BassScaleLength   float64
+	TrebleScaleLength float64
+	// PerpendicularFret is the fret where every string lines up, 0 being the nut
+	PerpendicularFret int
+}
+
+// FretDistance returns the distance from the nut to a fret using equal temperament spacing
+// without any compensation, so the 12th fret is right in the middle of the string.
+func FretDistance(scaleLength float64, fretNumber int) float64 {
+	return scaleLength - scaleLength/math.Pow(2, float64(fretNumber)/float64(music.PitchClassCount))
+}
+
+// FretTable returns the positions of frets 1 to numOfFrets for a scale length.
+func FretTable(scaleLength float64, numOfFrets int) ([]FretPosition, error) {
+	return fretTable(scaleLength, numOfFrets, 0)
+}
+
+func NewMultiscale(bassScaleLength float64, trebleScaleLength float64, perpendicularFret int) (*Multiscale, error) {
+	if bassScaleLength <= 0 || trebleScaleLength <= 0 {
+		return nil, fmt.Errorf("scale lengths have to be positive, got '%g' and '%g'", bassScaleLength, trebleScaleLength)
+	}
+
+	if perpendicularFret < 0 {
+		return nil, fmt.Errorf("perpendicular fret '%d' doesn't exist", perpendicularFret)
+	}
+
+	return &Multiscale{
+		BassScaleLength:   bassScaleLength,
+		TrebleScaleLength: trebleScaleLength,
+		PerpendicularFret: perpendicularFret,
+	}, nil
+}
+
+// ScaleLength returns the scale length of a string, with string 1 having the treble scale length
+// and the last string the bass one.
+func (m *Multiscale) ScaleLength(stringNumber int, stringCount int) float64 {
+	if stringCount < 2 {
+		return m.TrebleScaleLength
+	}
+
+	ratio := float64(stringNumber-1) / float64(stringCount-1)
+	return m.TrebleScaleLength + ratio*(m.BassScaleLength-m.TrebleScaleLength)
+}
+
+// NutOffset returns how far along the neck the nut of a string is from the perpendicular fret,
+// which is always zero or negative.
+func (m *Multiscale) NutOffset(stringNumber int, stringCount int) float64 {
+	return -FretDistance(m.ScaleLength(stringNumber, stringCount), m.PerpendicularFret)
+}
+
+// FretTable returns the positions of frets 1 to numOfFrets on one of the strings.
+func (m *Multiscale) FretTable(stringNumber int, stringCount int, numOfFrets int) ([]FretPosition, error) {
+	if stringNumber < 1 || stringNumber > stringCount {
+		return nil, fmt.Errorf("string '%d' doesn't exist", stringNumber)
+	}
+
+	return fretTable(m.ScaleLength(stringNumber, stringCount), numOfFrets, m.PerpendicularFret)
+}
+
+// FretTable returns the positions of the frets on a string, using the multiscale layout when
+// the fretboard has one and its scale length otherwise. Like elsewhere, numbers are counted from
+// the nut, so the table starts at the first fret after the string's StartFret.
+func (f *Fretboard) FretTable(stringNumber int) ([]FretPosition, error) {
+	if err := f.checkStringNumber(stringNumber); err != nil {
+		return nil, err
+	}
+
+	str := f.Strings[stringNumber-1]
+
+	var table []FretPosition
+	var err error
+	switch {
+	case f.Multiscale != nil:
+		table, err = f.Multiscale.FretTable(stringNumber, len(f.Strings), str.LastFret())
+	case f.ScaleLength > 0:
+		table, err = FretTable(f.ScaleLength, str.LastFret())
+	default:
+		return nil, fmt.Errorf("fretboard doesn't have a scale length")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return table[min(str.StartFret, len(table)):], nil
+}
+
+func fretTable(scaleLength float64, numOfFrets int, perpendicularFret int) ([]FretPosition, error) {
+	if scaleLength <= 0 {
+		return nil, fmt.Errorf("scale length has to be positive, got '%g'", scaleLength)
+	}
+
+	if numOfFrets < 0 {
+		return nil, fmt.Errorf("number of frets can't be negative, got '%d'", numOfFrets)
+	}
+
+	perpendicular := FretDistance(scaleLength, perpendicularFret)
+	table := make([]FretPosition, numOfFrets)
+
+	for i := range table {
+		fretNumber := i + 1
+		fromNut := FretDistance(scaleLength, fretNumber)
+
+		table[i] = FretPosition{
+			Fret:              fretNumber,
+			FromNut:           fromNut,
+			FromPreviousFret:  fromNut - FretDistance(scaleLength, fretNumber-1),
+			FromPerpendicular: fromNut - perpendicular,
+		}
+	}
+
+	return table, nil
+}
@@ -0,0 +1,89 @@
+package instrument
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFretDistance(t *testing.T) {
+	assert.Equal(t, 0.0, FretDistance(25.5, 0))
+	assert.InDelta(t, 1.431, FretDistance(25.5, 1), 0.001)
+	assert.InDelta(t, 12.75, FretDistance(25.5, 12), 1e-9)
+	assert.InDelta(t, 19.125, FretDistance(25.5, 24), 1e-9)
+	assert.InDelta(t, 324, FretDistance(648, 12), 1e-9)
+}
+
+func TestFretTable(t *testing.T) {
+	table, err := FretTable(25.5, 12)
+	assert.Nil(t, err)
+	assert.Len(t, table, 12)
+
+	assert.Equal(t, 1, table[0].Fret)
+	assert.InDelta(t, 1.431, table[0].FromNut, 0.001)
+	assert.InDelta(t, table[0].FromNut, table[0].FromPreviousFret, 1e-9)
+	assert.InDelta(t, 1.351, table[1].FromPreviousFret, 0.001)
+	assert.InDelta(t, table[11].FromNut, table[11].FromPerpendicular, 1e-9)
+
+	// frets get closer together going up the neck
+	for i := 1; i < len(table); i++ {
+		assert.Less(t, table[i].FromPreviousFret, table[i-1].FromPreviousFret)
+	}
+
+	_, err = FretTable(0, 12)
+	assert.Error(t, err)
+
+	_, err = FretTable(25.5, -1)
+	assert.Error(t, err)
+}
+
+func TestMultiscale(t *testing.T) {
+	multiscale, err := NewMultiscale(27, 25.5, 7)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 25.5, multiscale.ScaleLength(1, 7))
+	assert.Equal(t, 26.25, multiscale.ScaleLength(4, 7))
+	assert.Equal(t, 27.0, multiscale.ScaleLength(7, 7))
+
+	assert.InDelta(t, -FretDistance(27, 7), multiscale.NutOffset(7, 7), 1e-9)
+
+	// every string lines up on the perpendicular fret
+	for stringNumber := 1; stringNumber <= 7; stringNumber++ {
+		table, err := multiscale.FretTable(stringNumber, 7, 24)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0, table[6].FromPerpendicular, 1e-9)
+		assert.Less(t, table[5].FromPerpendicular, 0.0)
+		assert.InDelta(t, multiscale.ScaleLength(stringNumber, 7)/2, table[11].FromNut, 1e-9)
+	}
+
+	_, err = multiscale.FretTable(8, 7, 24)
+	assert.Error(t, err)
+
+	_, err = NewMultiscale(27, 0, 7)
+	assert.Error(t, err)
+
+	_, err = NewMultiscale(27, 25.5, -1)
+	assert.Error(t, err)
+}
+
+func TestFretboard_FretTable(t *testing.T) {
+	profile, _ := FindProfile("banjo")
+	fretboard := profile.NewFretboard()
+
+	table, err := fretboard.FretTable(1)
+	assert.Nil(t, err)
+	assert.Len(t, table, 22)
+	assert.InDelta(t, 13.125, table[11].FromNut, 1e-9)
+
+	// the short string only has the frets above its own nut
+	table, err = fretboard.FretTable(5)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, table[0].Fret)
+	assert.Len(t, table, 17)
+
+	fretboard.Multiscale, _ = NewMultiscale(27, 25.5, 0)
+	table, _ = fretboard.FretTable(5)
+	assert.InDelta(t, FretDistance(27, 6), table[0].FromNut, 1e-9)
+
+	_, err = NewFretboard(24, StandardTuning()).FretTable(1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,290 @@
+package instrument
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Fingering is a position with the finger suggested to fret it, from 1 (index) to 4 (pinky) or 0
+// for an open string.
+type Fingering struct {
+	Position
+	Finger int
+}
+
+// ScalePattern is a way of playing a scale in one area of the neck, with its fingerings going
+// from the lowest pitch to the highest one.
+type ScalePattern struct {
+	Name       string
+	Fingerings []Fingering
+}
+
+// cagedShape places the fret window of a CAGED shape around the root of the scale on one string.
+type cagedShape struct {
+	name         string
+	rootString   int
+	lowestOffset int
+	// highestOffset is relative to the root fret like lowestOffset
+	highestOffset int
+}
+
+// the five shapes, named after the open chord each one is built around, in the order they follow
+// each other up the neck
+var cagedShapes = []cagedShape{
+	{name: "C", rootString: 5, lowestOffset: -3, highestOffset: 0},
+	{name: "A", rootString: 5, lowestOffset: -1, highestOffset: 2},
+	{name: "G", rootString: 6, lowestOffset: -4, highestOffset: 0},
+	{name: "E", rootString: 6, lowestOffset: -1, highestOffset: 2},
+	{name: "D", rootString: 4, lowestOffset: -1, highestOffset: 3},
+}
+
+// CAGEDPatterns returns the five CAGED positions of a scale, each one covering the frets around
+// the root of the chord shape it's named after, sorted from the one closest to the nut. They
+// assume the strings of a guitar in standard tuning.
+func (f *Fretboard) CAGEDPatterns(scale *music.Scale) ([]ScalePattern, error) {
+	if len(f.Strings) != 6 {
+		return nil, fmt.Errorf("CAGED shapes need a 6-string fretboard, got %d strings", len(f.Strings))
+	}
+
+	patterns := make([]ScalePattern, 0, len(cagedShapes))
+	for _, shape := range cagedShapes {
+		rootFret, err := f.lowestRootFret(scale, shape.rootString, -shape.lowestOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		lowestFret := rootFret + shape.lowestOffset
+		highestFret := rootFret + shape.highestOffset
+		if highestFret > f.FretCount()-1 {
+			return nil, fmt.Errorf("%s shape doesn't fit on the fretboard", shape.name)
+		}
+
+		patterns = append(patterns, ScalePattern{
+			Name:       shape.name + " shape",
+			Fingerings: f.windowFingerings(scale, lowestFret, highestFret, lowestFret),
+		})
+	}
+
+	slices.SortStableFunc(patterns, func(a, b ScalePattern) int {
+		return a.LowestFret() - b.LowestFret()
+	})
+
+	return patterns, nil
+}
+
+// ThreeNotesPerStringPatterns returns a pattern starting on every note of the scale found on the
+// lowest string within the first octave, playing three consecutive scale notes on each string.
+// Patterns that would need frets behind the nut (or the capo) are left out.
+func (f *Fretboard) ThreeNotesPerStringPatterns(scale *music.Scale) ([]ScalePattern, error) {
+	patterns := make([]ScalePattern, 0, len(scale.Notes))
+
+	for _, startFret := range f.firstOctaveScaleFrets(scale) {
+		fingerings, ok := f.notesPerStringFingerings(scale, startFret, 3)
+		if !ok {
+			continue
+		}
+
+		patterns = append(patterns, ScalePattern{
+			Name:       "3 notes per string from fret " + strconv.Itoa(startFret),
+			Fingerings: fingerings,
+		})
+	}
+
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("%s can't be played with three notes per string on this fretboard", scale.String())
+	}
+
+	return patterns, nil
+}
+
+// BoxPatterns returns a box starting on every note of the scale found on the lowest string
+// within the first octave, from the one closest to the nut. A box covers the four frets from its
+// starting note with one finger per fret, plus the fret below it for the index finger to stretch
+// to, which gives the five pentatonic boxes for pentatonic scales. Boxes are numbered after the
+// scale degree they start on, so box 1 starts on the root.
+func (f *Fretboard) BoxPatterns(scale *music.Scale) ([]ScalePattern, error) {
+	patterns := make([]ScalePattern, 0, len(scale.Notes))
+
+	for _, startFret := range f.firstOctaveScaleFrets(scale) {
+		highestFret := startFret + 3
+		if highestFret > f.FretCount()-1 {
+			continue
+		}
+
+		startNote, err := f.GetNoteAt(len(f.Strings), startFret)
+		if err != nil {
+			return nil, err
+		}
+		degree := slices.IndexFunc(scale.Notes, startNote.EnharmonicEquals) + 1
+
+		patterns = append(patterns, ScalePattern{
+			Name:       "Box " + strconv.Itoa(degree),
+			Fingerings: f.windowFingerings(scale, max(startFret-1, 0), highestFret, startFret),
+		})
+	}
+
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("%s doesn't fit in a box on this fretboard", scale.String())
+	}
+
+	return patterns, nil
+}
+
+func (p *ScalePattern) Positions() []Position {
+	positions := make([]Position, len(p.Fingerings))
+	for i, fingering := range p.Fingerings {
+		positions[i] = fingering.Position
+	}
+
+	return positions
+}
+
+func (p *ScalePattern) LowestFret() int {
+	lowestFret := math.MaxInt
+	for _, fingering := range p.Fingerings {
+		lowestFret = min(lowestFret, fingering.FretNumber)
+	}
+
+	return lowestFret
+}
+
+func (p *ScalePattern) HighestFret() int {
+	highestFret := 0
+	for _, fingering := range p.Fingerings {
+		highestFret = max(highestFret, fingering.FretNumber)
+	}
+
+	return highestFret
+}
+
+// DrawScalePattern draws the fretboard with the suggested finger on every position of the
+// pattern.
+func (f *Fretboard) DrawScalePattern(pattern ScalePattern) (string, error) {
+	return f.drawCells(3, func(position Position, note *music.Note) string {
+		for _, fingering := range pattern.Fingerings {
+			if fingering.Position == position {
+				return strconv.Itoa(fingering.Finger)
+			}
+		}
+
+		return "-"
+	})
+}
+
+// lowestRootFret returns the lowest fret with the root of the scale on a string that leaves at
+// least minimumFret playable frets below it.
+func (f *Fretboard) lowestRootFret(scale *music.Scale, stringNumber int, minimumFret int) (int, error) {
+	str := f.Strings[stringNumber-1]
+
+	for fretNumber := f.lowestFret(stringNumber) + minimumFret; fretNumber <= str.LastFret(); fretNumber++ {
+		note, err := str.NoteAt(fretNumber)
+		if err == nil && note.EnharmonicEquals(&scale.Root) {
+			return fretNumber, nil
+		}
+	}
+
+	return 0, fmt.Errorf("root '%s' not found on string '%d'", scale.Root.String(), stringNumber)
+}
+
+// firstOctaveScaleFrets returns the frets of the scale notes on the lowest string, within the
+// first octave from its lowest playable fret.
+func (f *Fretboard) firstOctaveScaleFrets(scale *music.Scale) []int {
+	stringNumber := len(f.Strings)
+	str := f.Strings[stringNumber-1]
+	lowestFret := f.lowestFret(stringNumber)
+
+	frets := make([]int, 0, len(scale.Notes))
+	for fretNumber := lowestFret; fretNumber < lowestFret+music.PitchClassCount && fretNumber <= str.LastFret(); fretNumber++ {
+		note, err := str.NoteAt(fretNumber)
+		if err == nil && scale.Contains(note) {
+			frets = append(frets, fretNumber)
+		}
+	}
+
+	return frets
+}
+
+// windowFingerings returns every scale note between two frets, from the lowest string to the
+// highest one. The index finger covers indexFret, and the frets below it too.
+func (f *Fretboard) windowFingerings(scale *music.Scale, lowestFret int, highestFret int, indexFret int) []Fingering {
+	fingerings := make([]Fingering, 0)
+
+	for stringNumber := len(f.Strings); stringNumber >= 1; stringNumber-- {
+		for fretNumber := max(lowestFret, f.lowestFret(stringNumber)); fretNumber <= highestFret; fretNumber++ {
+			note, err := f.Strings[stringNumber-1].NoteAt(fretNumber)
+			if err != nil || !scale.Contains(note) {
+				continue
+			}
+
+			fingerings = append(fingerings, Fingering{
+				Position: Position{StringNumber: stringNumber, FretNumber: fretNumber},
+				Finger:   f.fingerFor(stringNumber, fretNumber, indexFret, highestFret),
+			})
+		}
+	}
+
+	return fingerings
+}
+
+// notesPerStringFingerings plays notesPerString consecutive scale notes on every string, starting
+// from a fret of the lowest string.
+func (f *Fretboard) notesPerStringFingerings(scale *music.Scale, startFret int, notesPerString int) ([]Fingering, bool) {
+	pitch, err := f.PitchAt(len(f.Strings), startFret)
+	if err != nil {
+		return nil, false
+	}
+	midiNumber := pitch.MIDI()
+
+	fingerings := make([]Fingering, 0, len(f.Strings)*notesPerString)
+	for stringNumber := len(f.Strings); stringNumber >= 1; stringNumber-- {
+		openPitch, err := f.PitchAt(stringNumber, f.Strings[stringNumber-1].StartFret)
+		if err != nil {
+			return nil, false
+		}
+
+		stringFrets := make([]int, 0, notesPerString)
+		for len(stringFrets) < notesPerString {
+			pitchClass := music.PitchClass(midiNumber % music.PitchClassCount)
+			if scale.Contains(pitchClass.Note()) {
+				fretNumber := midiNumber - openPitch.MIDI() + f.Strings[stringNumber-1].StartFret
+				if fretNumber < f.lowestFret(stringNumber) || !f.Strings[stringNumber-1].HasFret(fretNumber) {
+					return nil, false
+				}
+				stringFrets = append(stringFrets, fretNumber)
+			}
+			midiNumber++
+		}
+
+		for _, fretNumber := range stringFrets {
+			fingerings = append(fingerings, Fingering{
+				Position: Position{StringNumber: stringNumber, FretNumber: fretNumber},
+				Finger:   f.fingerFor(stringNumber, fretNumber, stringFrets[0], stringFrets[len(stringFrets)-1]),
+			})
+		}
+	}
+
+	return fingerings, true
+}
+
+// fingerFor spreads the fingers over the frets between indexFret and pinkyFret, one finger per
+// fret when they're four frets apart or less. Open strings, or strings held down by the capo,
+// don't need a finger.
+func (f *Fretboard) fingerFor(stringNumber int, fretNumber int, indexFret int, pinkyFret int) int {
+	if fretNumber == f.lowestFret(stringNumber) {
+		return 0
+	}
+
+	if fretNumber <= indexFret {
+		return 1
+	}
+
+	span := pinkyFret - indexFret
+	if span <= 3 {
+		return fretNumber - indexFret + 1
+	}
+
+	return 1 + int(math.Round(float64(fretNumber-indexFret)*3/float64(span)))
+}
@@ -0,0 +1,137 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestFretboard_CAGEDPatterns(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	root, _ := music.FindNote(music.C, music.Natural)
+	scale, _ := music.NewScale(root, music.MajorScale)
+
+	patterns, err := fretboard.CAGEDPatterns(scale)
+	assert.Nil(t, err)
+
+	names := make([]string, 0)
+	windows := make([][2]int, 0)
+	for _, pattern := range patterns {
+		names = append(names, pattern.Name)
+		windows = append(windows, [2]int{pattern.LowestFret(), pattern.HighestFret()})
+
+		// every position is in the scale and goes from the lowest string to the highest one
+		for i, fingering := range pattern.Fingerings {
+			note, _ := fretboard.GetNoteAt(fingering.StringNumber, fingering.FretNumber)
+			assert.True(t, scale.Contains(note), "%s: %v", pattern.Name, fingering.Position)
+			assert.GreaterOrEqual(t, fingering.Finger, 0)
+			assert.LessOrEqual(t, fingering.Finger, 4)
+			if i > 0 {
+				assert.LessOrEqual(t, fingering.StringNumber, pattern.Fingerings[i-1].StringNumber)
+			}
+		}
+	}
+
+	assert.Equal(t, []string{"C shape", "A shape", "G shape", "E shape", "D shape"}, names)
+	assert.Equal(t, [][2]int{{0, 3}, {2, 5}, {4, 8}, {7, 10}, {9, 13}}, windows)
+
+	// CAGED shapes are guitar shapes
+	profile, _ := FindProfile("bass")
+	bass := profile.NewFretboard()
+	_, err = bass.CAGEDPatterns(scale)
+	assert.Error(t, err)
+}
+
+func TestFretboard_ThreeNotesPerStringPatterns(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	root, _ := music.FindNote(music.G, music.Natural)
+	scale, _ := music.NewScale(root, music.MajorScale)
+
+	patterns, err := fretboard.ThreeNotesPerStringPatterns(scale)
+	assert.Nil(t, err)
+	assert.Len(t, patterns, 7)
+	assert.Equal(t, "3 notes per string from fret 2", patterns[1].Name)
+
+	// two octaves and a half of the scale, three notes on every string
+	pattern := patterns[1]
+	assert.Len(t, pattern.Fingerings, 18)
+	assert.Equal(t, []Fingering{
+		{Position: Position{StringNumber: 6, FretNumber: 2}, Finger: 1},
+		{Position: Position{StringNumber: 6, FretNumber: 3}, Finger: 2},
+		{Position: Position{StringNumber: 6, FretNumber: 5}, Finger: 4},
+	}, pattern.Fingerings[:3])
+	assert.Equal(t, []Fingering{
+		{Position: Position{StringNumber: 2, FretNumber: 3}, Finger: 1},
+		{Position: Position{StringNumber: 2, FretNumber: 5}, Finger: 3},
+		{Position: Position{StringNumber: 2, FretNumber: 7}, Finger: 4},
+	}, pattern.Fingerings[12:15])
+
+	// open strings don't need a finger
+	assert.Equal(t, 0, patterns[0].Fingerings[0].Finger)
+}
+
+func TestFretboard_BoxPatterns(t *testing.T) {
+	fretboard := NewFretboard(24, StandardTuning())
+
+	root, _ := music.FindNote(music.A, music.Natural)
+	scale, _ := music.NewScale(root, music.MinorPentatonicScale)
+
+	patterns, err := fretboard.BoxPatterns(scale)
+	assert.Nil(t, err)
+	assert.Len(t, patterns, 5)
+
+	// boxes are numbered from the root, the first one being the open box
+	assert.Equal(t, "Box 4", patterns[0].Name)
+	assert.Equal(t, 0, patterns[0].LowestFret())
+
+	box := patterns[2]
+	assert.Equal(t, "Box 1", box.Name)
+	assert.Equal(t, []Position{
+		{StringNumber: 6, FretNumber: 5}, {StringNumber: 6, FretNumber: 8},
+		{StringNumber: 5, FretNumber: 5}, {StringNumber: 5, FretNumber: 7},
+		{StringNumber: 4, FretNumber: 5}, {StringNumber: 4, FretNumber: 7},
+		{StringNumber: 3, FretNumber: 5}, {StringNumber: 3, FretNumber: 7},
+		{StringNumber: 2, FretNumber: 5}, {StringNumber: 2, FretNumber: 8},
+		{StringNumber: 1, FretNumber: 5}, {StringNumber: 1, FretNumber: 8},
+	}, box.Positions())
+	assert.Equal(t, 1, box.Fingerings[0].Finger)
+	assert.Equal(t, 4, box.Fingerings[1].Finger)
+	assert.Equal(t, 3, box.Fingerings[3].Finger)
+
+	// the index finger stretches back a fret in box 2
+	box = patterns[3]
+	assert.Equal(t, "Box 2", box.Name)
+	assert.Equal(t, Fingering{Position: Position{StringNumber: 5, FretNumber: 7}, Finger: 1}, box.Fingerings[2])
+
+	// nothing is played behind the capo, and the capo takes the place of the open strings
+	assert.Nil(t, fretboard.SetCapo(NewCapo(2)))
+	patterns, err = fretboard.BoxPatterns(scale)
+	assert.Nil(t, err)
+	assert.Equal(t, "Box 5", patterns[0].Name)
+	assert.Equal(t, 2, patterns[0].LowestFret())
+	assert.Equal(t, Fingering{Position: Position{StringNumber: 4, FretNumber: 2}, Finger: 0}, patterns[0].Fingerings[4])
+}
+
+func TestFretboard_DrawScalePattern(t *testing.T) {
+	fretboard := NewFretboard(9, StandardTuning())
+
+	root, _ := music.FindNote(music.A, music.Natural)
+	scale, _ := music.NewScale(root, music.MinorPentatonicScale)
+
+	patterns, _ := fretboard.BoxPatterns(scale)
+	ret, err := fretboard.DrawScalePattern(patterns[1])
+	assert.Nil(t, err)
+
+	assert.Equal(t, strings.TrimSpace(`
+| 0  | 1  | 2  | 3  | 4  | 5  | 6  | 7  | 8  |
+| -  | -  | -  | 1  | -  | 3  | -  | -  | -  |
+| -  | -  | -  | 1  | -  | 3  | -  | -  | -  |
+| -  | -  | 1  | -  | -  | 3  | -  | -  | -  |
+| -  | -  | 1  | -  | -  | 3  | -  | -  | -  |
+| -  | -  | -  | 1  | -  | 3  | -  | -  | -  |
+| -  | -  | -  | 1  | -  | 3  | -  | -  | -  |
+`), ret)
+}
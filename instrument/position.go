@@ -0,0 +1,16 @@
+package instrument
+
+import (
+	"fmt"
+)
+
+// Position is a fretted (or open) note on the fretboard. Strings are 1-indexed like in the real
+// world, so string 1 is the highest pitched one.
+type Position struct {
+	StringNumber int
+	FretNumber   int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("string %d fret %d", p.StringNumber, p.FretNumber)
+}
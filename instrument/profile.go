@@ -0,0 +1,139 @@
+package instrument
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Profile describes a fretted instrument with its usual tuning and number of frets.
+type Profile struct {
+	Name string
+	// Aliases are other names the profile can be found by (e.g. "uke")
+	Aliases []string
+	Tuning  *Tuning
+	// Frets is the number of frets, not counting the open string, so a 24-fret guitar has fret
+	// positions 0 to 24
+	Frets int
+	// StartFrets lists the fret each string starts at in string order, for strings that don't
+	// start at the nut. Strings left out start at fret 0.
+	StartFrets []int
+	// StringFrets lists how many frets each string has above its own nut in string order, for
+	// strings that end before the last fret. Strings left out (or 0) end on the last fret.
+	StringFrets []int
+	// Inlays are the frets with position markers
+	Inlays []int
+	// ScaleLength is the length of the vibrating strings in inches, from the nut to the saddle
+	ScaleLength float64
+	// Multiscale is only set for fanned-fret instruments
+	Multiscale *Multiscale
+	// TensionLimits are the string tensions the instrument is comfortable to play with
+	TensionLimits TensionLimits
+}
+
+// most fretted instruments mark the same frets, with a double inlay on the 12th
+var standardInlays = []int{3, 5, 7, 9, 12, 15, 17, 19, 21, 24}
+
+var profiles = []*Profile{
+	{Name: "guitar", Aliases: []string{"6-string guitar"}, Tuning: StandardTuning(), Frets: 24, Inlays: standardInlays, ScaleLength: 25.5, TensionLimits: GuitarTensionLimits},
+	{Name: "7-string guitar", Aliases: []string{"guitar7"}, Tuning: mustTuning("7-String Standard", "B1 E2 A2 D3 G3 B3 E4"), Frets: 24, Inlays: standardInlays, ScaleLength: 25.5, TensionLimits: GuitarTensionLimits},
+	{Name: "8-string guitar", Aliases: []string{"guitar8"}, Tuning: mustTuning("8-String Standard", "F#1 B1 E2 A2 D3 G3 B3 E4"), Frets: 24, Inlays: standardInlays, ScaleLength: 27, TensionLimits: GuitarTensionLimits},
+	{Name: "bass", Aliases: []string{"4-string bass", "bass4"}, Tuning: mustTuning("Bass Standard", "E1 A1 D2 G2"), Frets: 21, Inlays: standardInlays, ScaleLength: 34, TensionLimits: BassTensionLimits},
+	{Name: "5-string bass", Aliases: []string{"bass5"}, Tuning: mustTuning("5-String Bass Standard", "B0 E1 A1 D2 G2"), Frets: 24, Inlays: standardInlays, ScaleLength: 35, TensionLimits: BassTensionLimits},
+	{Name: "6-string bass", Aliases: []string{"bass6"}, Tuning: mustTuning("6-String Bass Standard", "B0 E1 A1 D2 G2 C3"), Frets: 24, Inlays: standardInlays, ScaleLength: 35, TensionLimits: BassTensionLimits},
+	// re-entrant: the 4th string is tuned higher than the 3rd
+	{Name: "ukulele", Aliases: []string{"uke"}, Tuning: mustTuning("Ukulele Standard", "G4 C4 E4 A4"), Frets: 15, Inlays: standardInlays, ScaleLength: 13, TensionLimits: TensionLimits{Min: 6, Max: 16}},
+	{Name: "mandolin", Tuning: mustTuning("Mandolin Standard", "G3 D4 A4 E5"), Frets: 20, Inlays: standardInlays, ScaleLength: 13.875, TensionLimits: TensionLimits{Min: 14, Max: 30}},
+	{Name: "tenor guitar", Aliases: []string{"tenor"}, Tuning: mustTuning("Tenor Standard", "C3 G3 D4 A4"), Frets: 19, Inlays: standardInlays, ScaleLength: 23, TensionLimits: GuitarTensionLimits},
+	// open G, with the short 5th string being the high G that starts at the 5th fret
+	{Name: "banjo", Aliases: []string{"5-string banjo"}, Tuning: mustTuning("Banjo Open G", "G4 D3 G3 B3 D4"), Frets: 22, StartFrets: []int{0, 0, 0, 0, 5}, Inlays: standardInlays, ScaleLength: 26.25, TensionLimits: TensionLimits{Min: 10, Max: 22}},
+}
+
+func Profiles() []*Profile {
+	return append([]*Profile{}, profiles...)
+}
+
+// FindProfile looks up an instrument by name or alias, ignoring case, spaces and hyphens
+// (e.g. "7 string guitar" or "Bass5").
+func FindProfile(name string) (*Profile, error) {
+	normalizedName := normalizeName(name)
+
+	for _, profile := range profiles {
+		names := append([]string{profile.Name}, profile.Aliases...)
+		for _, profileName := range names {
+			if normalizeName(profileName) == normalizedName {
+				return profile.clone(), nil
+			}
+		}
+	}
+
+	profileNames := make([]string, len(profiles))
+	for i, profile := range profiles {
+		profileNames[i] = profile.Name
+	}
+
+	return nil, fmt.Errorf("instrument '%s' not found, expected one of: %s", name, strings.Join(profileNames, ", "))
+}
+
+// NewFretboard builds a fretboard for the instrument using its own tuning. Strings that start
+// above the nut have their open note on their start fret, and strings end on the last fret unless
+// StringFrets says otherwise.
+func (p *Profile) NewFretboard() *Fretboard {
+	// the open string is a fret position too
+	fretPositions := p.Frets + 1
+
+	fretboard := NewFretboard(fretPositions, p.Tuning)
+	fretboard.Inlays = slices.DeleteFunc(slices.Clone(p.Inlays), func(fretNumber int) bool {
+		return fretNumber > p.Frets
+	})
+	fretboard.ScaleLength = p.ScaleLength
+	fretboard.Multiscale = p.Multiscale
+
+	for i, pitch := range p.Tuning.Pitches {
+		startFret, stringFrets := 0, 0
+		if i < len(p.StartFrets) {
+			startFret = p.StartFrets[i]
+		}
+		if i < len(p.StringFrets) {
+			stringFrets = p.StringFrets[i]
+		}
+
+		if startFret > 0 || stringFrets > 0 {
+			if stringFrets == 0 {
+				stringFrets = p.Frets - startFret
+			}
+			fretboard.Strings[i] = NewPitchedString(pitch, startFret, stringFrets+1)
+		}
+	}
+
+	return fretboard
+}
+
+func (p *Profile) StringCount() int {
+	return p.Tuning.StringCount()
+}
+
+func (p *Profile) String() string {
+	return fmt.Sprintf("%s, %d frets, tuned to %s", p.Name, p.Frets, p.Tuning.String())
+}
+
+func (p *Profile) clone() *Profile {
+	var multiscale *Multiscale
+	if p.Multiscale != nil {
+		copied := *p.Multiscale
+		multiscale = &copied
+	}
+
+	return &Profile{
+		Name:          p.Name,
+		Aliases:       append([]string{}, p.Aliases...),
+		Tuning:        p.Tuning.clone(),
+		Frets:         p.Frets,
+		StartFrets:    append([]int{}, p.StartFrets...),
+		StringFrets:   append([]int{}, p.StringFrets...),
+		Inlays:        append([]int{}, p.Inlays...),
+		ScaleLength:   p.ScaleLength,
+		Multiscale:    multiscale,
+		TensionLimits: p.TensionLimits,
+	}
+}
@@ -0,0 +1,187 @@
+package instrument
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// profileFile is how an instrument is described in a YAML or JSON file, for example:
+//
+//	name: baritone 7
+//	strings: 7
+//	tuning: A1 D2 G2 C3 F3 A3 D4
+//	frets: 24
+//	inlays: [3, 5, 7, 9, 12, 15, 17, 19, 21, 24]
+//	scale_length: 27
+//	tension_limits: {min: 12, max: 22}
+//
+// The tuning is written from the lowest string like for ParseTuning. Frets doesn't count the open
+// string, like Profile.Frets, so 24 gives fret positions 0 to 24. start_frets maps string numbers
+// to the fret they start at (e.g. {5: 5} for a banjo), and string_frets maps them to how many frets
+// they have above their own nut when they end before the last fret. Fanned-fret instruments replace scale_length with:
+//
+//	multiscale:
+//	  bass_scale_length: 27
+//	  treble_scale_length: 25.5
+//	  perpendicular_fret: 7
+type profileFile struct {
+	Name        string      `json:"name" yaml:"name"`
+	Strings     int         `json:"strings" yaml:"strings"`
+	Tuning      string      `json:"tuning" yaml:"tuning"`
+	Frets       int         `json:"frets" yaml:"frets"`
+	StartFrets  map[int]int `json:"start_frets" yaml:"start_frets"`
+	StringFrets map[int]int `json:"string_frets" yaml:"string_frets"`
+	Inlays      []int       `json:"inlays" yaml:"inlays"`
+	ScaleLength float64     `json:"scale_length" yaml:"scale_length"`
+	Multiscale  *struct {
+		BassScaleLength   float64 `json:"bass_scale_length" yaml:"bass_scale_length"`
+		TrebleScaleLength float64 `json:"treble_scale_length" yaml:"treble_scale_length"`
+		PerpendicularFret int     `json:"perpendicular_fret" yaml:"perpendicular_fret"`
+	} `json:"multiscale" yaml:"multiscale"`
+	// TensionLimits defaults to GuitarTensionLimits
+	TensionLimits *struct {
+		Min float64 `json:"min" yaml:"min"`
+		Max float64 `json:"max" yaml:"max"`
+	} `json:"tension_limits" yaml:"tension_limits"`
+}
+
+// LoadProfile reads an instrument from a .yaml, .yml or .json file. The file name is used as the
+// instrument name when the file doesn't have one.
+func LoadProfile(path string) (*Profile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading instrument file '%s': %v", path, err)
+	}
+
+	var file profileFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	default:
+		return nil, fmt.Errorf("instrument file '%s' has to be a .yaml, .yml or .json file", path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing instrument file '%s': %v", path, err)
+	}
+
+	if file.Name == "" {
+		file.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	profile, err := file.toProfile()
+	if err != nil {
+		return nil, fmt.Errorf("instrument file '%s' is invalid: %v", path, err)
+	}
+
+	return profile, nil
+}
+
+// IsProfileFile tells whether an instrument name refers to a file rather than to one of the
+// known profiles.
+func IsProfileFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *profileFile) toProfile() (*Profile, error) {
+	if p.Tuning == "" {
+		return nil, fmt.Errorf("tuning is missing")
+	}
+
+	tuning, err := ParseTuning(p.Tuning)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Strings != 0 && p.Strings != tuning.StringCount() {
+		return nil, fmt.Errorf("instrument has %d strings but its tuning has %d", p.Strings, tuning.StringCount())
+	}
+
+	if p.Frets < 1 {
+		return nil, fmt.Errorf("instrument needs at least one fret, got '%d'", p.Frets)
+	}
+
+	startFrets := make([]int, tuning.StringCount())
+	for stringNumber, startFret := range p.StartFrets {
+		if stringNumber < 1 || stringNumber > tuning.StringCount() {
+			return nil, fmt.Errorf("string '%d' in start_frets doesn't exist", stringNumber)
+		}
+
+		if startFret < 0 || startFret >= p.Frets {
+			return nil, fmt.Errorf("string '%d' can't start at fret '%d'", stringNumber, startFret)
+		}
+
+		startFrets[stringNumber-1] = startFret
+	}
+
+	stringFrets := make([]int, tuning.StringCount())
+	for stringNumber, fretCount := range p.StringFrets {
+		if stringNumber < 1 || stringNumber > tuning.StringCount() {
+			return nil, fmt.Errorf("string '%d' in string_frets doesn't exist", stringNumber)
+		}
+
+		if fretCount < 1 || startFrets[stringNumber-1]+fretCount > p.Frets {
+			return nil, fmt.Errorf("string '%d' can't have '%d' frets", stringNumber, fretCount)
+		}
+
+		stringFrets[stringNumber-1] = fretCount
+	}
+
+	for _, inlay := range p.Inlays {
+		if inlay < 1 || inlay > p.Frets {
+			return nil, fmt.Errorf("inlay on fret '%d' doesn't exist", inlay)
+		}
+	}
+
+	if p.ScaleLength < 0 {
+		return nil, fmt.Errorf("scale length can't be negative, got '%g'", p.ScaleLength)
+	}
+
+	var multiscale *Multiscale
+	if p.Multiscale != nil {
+		multiscale, err = NewMultiscale(p.Multiscale.BassScaleLength, p.Multiscale.TrebleScaleLength, p.Multiscale.PerpendicularFret)
+		if err != nil {
+			return nil, err
+		}
+
+		if multiscale.PerpendicularFret > p.Frets {
+			return nil, fmt.Errorf("perpendicular fret '%d' doesn't exist", multiscale.PerpendicularFret)
+		}
+	}
+
+	tensionLimits := GuitarTensionLimits
+	if p.TensionLimits != nil {
+		if p.TensionLimits.Min < 0 || (p.TensionLimits.Max != 0 && p.TensionLimits.Max < p.TensionLimits.Min) {
+			return nil, fmt.Errorf("tension limits '%g' to '%g' aren't valid", p.TensionLimits.Min, p.TensionLimits.Max)
+		}
+		tensionLimits = TensionLimits{Min: p.TensionLimits.Min, Max: p.TensionLimits.Max}
+	}
+
+	return &Profile{
+		Name:          p.Name,
+		Tuning:        tuning,
+		Frets:         p.Frets,
+		StartFrets:    startFrets,
+		StringFrets:   stringFrets,
+		Inlays:        p.Inlays,
+		ScaleLength:   p.ScaleLength,
+		Multiscale:    multiscale,
+		TensionLimits: tensionLimits,
+	}, nil
+}
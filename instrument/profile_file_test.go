@@ -0,0 +1,167 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeProfileFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestProfile_LoadProfile_YAML(t *testing.T) {
+	path := writeProfileFile(t, "baritone.yaml", `
+name: baritone 7
+strings: 7
+tuning: A1 D2 G2 C3 F3 A3 D4
+frets: 24
+inlays: [3, 5, 7, 9, 12, 15, 17, 19, 21, 24]
+scale_length: 27
+`)
+
+	profile, err := LoadProfile(path)
+	if assert.Nil(t, err) {
+		assert.Equal(t, "baritone 7", profile.Name)
+		assert.Equal(t, 7, profile.StringCount())
+		assert.Equal(t, 24, profile.Frets)
+		assert.Equal(t, 27.0, profile.ScaleLength)
+
+		fretboard := profile.NewFretboard()
+		assert.Equal(t, 25, fretboard.FretCount())
+		assert.Contains(t, fretboard.Inlays, 24)
+
+		pitch, _ := fretboard.PitchAt(7, 0)
+		assert.Equal(t, "A1", pitch.String())
+	}
+}
+
+func TestProfile_LoadProfile_JSON(t *testing.T) {
+	path := writeProfileFile(t, "my-banjo.json", `{
+  "tuning": "G4 D3 G3 B3 D4",
+  "frets": 22,
+  "start_frets": {"5": 5}
+}`)
+
+	profile, err := LoadProfile(path)
+	if assert.Nil(t, err) {
+		// named after the file
+		assert.Equal(t, "my-banjo", profile.Name)
+
+		fretboard := profile.NewFretboard()
+		assert.Equal(t, 5, fretboard.Strings[4].StartFret)
+
+		note, err := fretboard.GetNoteAt(5, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, music.G, note.Name)
+	}
+
+	path = writeProfileFile(t, "short-banjo.json", `{
+  "tuning": "G4 D3 G3 B3 D4",
+  "frets": 22,
+  "start_frets": {"5": 5},
+  "string_frets": {"5": 12}
+}`)
+
+	profile, err = LoadProfile(path)
+	if assert.Nil(t, err) {
+		fretboard := profile.NewFretboard()
+		assert.Equal(t, 17, fretboard.Strings[4].LastFret())
+		assert.Equal(t, 22, fretboard.Strings[3].LastFret())
+	}
+}
+
+func TestProfile_LoadProfile_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+	}{
+		{"missing-tuning.yaml", "frets: 24"},
+		{"wrong-strings.yaml", "strings: 7\ntuning: E2 A2 D3 G3 B3 E4\nfrets: 24"},
+		{"no-frets.yaml", "tuning: E2 A2 D3 G3 B3 E4"},
+		{"bad-start-fret.yaml", "tuning: E2 A2 D3 G3 B3 E4\nfrets: 24\nstart_frets: {7: 5}"},
+		{"bad-string-frets.yaml", "tuning: E2 A2 D3 G3 B3 E4\nfrets: 24\nstring_frets: {7: 12}"},
+		{"too-many-string-frets.yaml", "tuning: G4 D3 G3 B3 D4\nfrets: 22\nstart_frets: {5: 5}\nstring_frets: {5: 18}"},
+		{"bad-inlay.yaml", "tuning: E2 A2 D3 G3 B3 E4\nfrets: 12\ninlays: [15]"},
+		{"typo.yaml", "tunning: E2 A2 D3 G3 B3 E4\nfrets: 24"},
+		{"broken.json", `{"tuning": `},
+		{"instrument.txt", "tuning: E2 A2 D3 G3 B3 E4"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := LoadProfile(writeProfileFile(t, testCase.name, testCase.content))
+		assert.Error(t, err, testCase.name)
+	}
+
+	_, err := LoadProfile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+
+	assert.True(t, IsProfileFile("instruments/baritone.YML"))
+	assert.False(t, IsProfileFile("bass"))
+}
+
+func TestProfile_LoadProfile_Multiscale(t *testing.T) {
+	path := writeProfileFile(t, "fanned.yml", `
+tuning: B1 E2 A2 D3 G3 B3 E4
+frets: 24
+multiscale:
+  bass_scale_length: 27
+  treble_scale_length: 25.5
+  perpendicular_fret: 7
+`)
+
+	profile, err := LoadProfile(path)
+	if assert.Nil(t, err) {
+		assert.Equal(t, 7, profile.Multiscale.PerpendicularFret)
+
+		table, err := profile.NewFretboard().FretTable(7)
+		assert.Nil(t, err)
+		assert.InDelta(t, 13.5, table[11].FromNut, 1e-9)
+	}
+
+	_, err = LoadProfile(writeProfileFile(t, "bad-fanned.yml", `
+tuning: E2 A2 D3 G3 B3 E4
+frets: 24
+multiscale:
+  bass_scale_length: 27
+  treble_scale_length: 25.5
+  perpendicular_fret: 30
+`))
+	assert.Error(t, err)
+}
+
+func TestProfile_LoadProfile_MatchesBuiltIn(t *testing.T) {
+	// frets counts the same way in files as in the built-in profiles
+	path := writeProfileFile(t, "guitar.yaml", `
+tuning: standard
+frets: 24
+inlays: [3, 5, 7, 9, 12, 15, 17, 19, 21, 24]
+scale_length: 25.5
+`)
+
+	profile, err := LoadProfile(path)
+	if assert.Nil(t, err) {
+		guitar, _ := FindProfile("guitar")
+		assert.Equal(t, guitar.NewFretboard(), profile.NewFretboard())
+		assert.Equal(t, 25, profile.NewFretboard().FretCount())
+	}
+
+	path = writeProfileFile(t, "banjo.json", `{
+  "tuning": "G4 D3 G3 B3 D4",
+  "frets": 22,
+  "start_frets": {"5": 5}
+}`)
+
+	profile, err = LoadProfile(path)
+	if assert.Nil(t, err) {
+		banjo, _ := FindProfile("banjo")
+		assert.Equal(t, banjo.NewFretboard().Strings, profile.NewFretboard().Strings)
+	}
+}
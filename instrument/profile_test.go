@@ -0,0 +1,87 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestProfile_FindProfile(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+		strings  int
+		frets    int
+		lowest   string
+	}{
+		{"guitar", "guitar", 6, 24, "E2"},
+		{"7 string guitar", "7-string guitar", 7, 24, "B1"},
+		{"guitar8", "8-string guitar", 8, 24, "F#1"},
+		{"Bass", "bass", 4, 21, "E1"},
+		{"bass5", "5-string bass", 5, 24, "B0"},
+		{"6-string bass", "6-string bass", 6, 24, "B0"},
+		{"uke", "ukulele", 4, 15, "G4"},
+		{"mandolin", "mandolin", 4, 20, "G3"},
+		{"tenor", "tenor guitar", 4, 19, "C3"},
+		{"banjo", "banjo", 5, 22, "G4"},
+	}
+
+	for _, testCase := range testCases {
+		profile, err := FindProfile(testCase.name)
+		if assert.Nil(t, err, testCase.name) {
+			assert.Equal(t, testCase.expected, profile.Name)
+			assert.Equal(t, testCase.strings, profile.StringCount(), testCase.name)
+			assert.Equal(t, testCase.frets, profile.Frets, testCase.name)
+			assert.Equal(t, testCase.lowest, profile.Tuning.Pitches[profile.StringCount()-1].String(), testCase.name)
+		}
+	}
+
+	_, err := FindProfile("theremin")
+	assert.Error(t, err)
+
+	assert.Len(t, Profiles(), 10)
+}
+
+func TestProfile_NewFretboard(t *testing.T) {
+	profile, _ := FindProfile("ukulele")
+	fretboard := profile.NewFretboard()
+
+	assert.Len(t, fretboard.Strings, 4)
+	assert.Len(t, fretboard.Strings[0].FretNotes, 16)
+
+	// re-entrant tuning: the 4th string is higher than the 3rd
+	note, _ := fretboard.GetNoteAt(4, 0)
+	assert.Equal(t, music.G, note.Name)
+	assert.Greater(t, fretboard.Tuning.Pitches[3].MIDI(), fretboard.Tuning.Pitches[2].MIDI())
+
+	// changing a profile doesn't change the catalogue
+	profile.Tuning, _ = ParseTuning("A D F# B")
+	profile, _ = FindProfile("ukulele")
+	assert.Equal(t, "Ukulele Standard (G4 C4 E4 A4)", profile.Tuning.String())
+	assert.Equal(t, "ukulele, 15 frets, tuned to Ukulele Standard (G4 C4 E4 A4)", profile.String())
+}
+
+func TestProfile_NewFretboard_StringFrets(t *testing.T) {
+	profile, _ := FindProfile("guitar")
+	profile.StringFrets = []int{0, 0, 0, 0, 22, 21}
+	fretboard := profile.NewFretboard()
+
+	assert.Equal(t, 25, fretboard.FretCount())
+	assert.Equal(t, 24, fretboard.Strings[0].LastFret())
+	assert.Equal(t, 22, fretboard.Strings[4].LastFret())
+	assert.Equal(t, 21, fretboard.Strings[5].LastFret())
+
+	_, err := fretboard.GetNoteAt(6, 22)
+	assert.Error(t, err)
+
+	pitch, err := fretboard.PitchAt(6, 21)
+	assert.Nil(t, err)
+	assert.Equal(t, "C#4", pitch.String())
+
+	// the short string of a banjo counts its frets from its own nut
+	profile, _ = FindProfile("banjo")
+	profile.StringFrets = []int{0, 0, 0, 0, 12}
+	fretboard = profile.NewFretboard()
+	assert.Equal(t, 5, fretboard.Strings[4].StartFret)
+	assert.Equal(t, 17, fretboard.Strings[4].LastFret())
+}
@@ -1,51 +1,97 @@
 package instrument
 
 import (
+	"fmt"
 	"github.com/PauloMigAlmeida/fretboard-games/music"
 )
 
 type String struct {
+	// StartFret is the fret the string's own nut sits on, which is 0 for most strings but 5 for
+	// the short 5th string of a banjo
+	StartFret int
+	// FretNotes starts with the open string, so FretNotes[0] sounds at StartFret
 	FretNotes []music.Note
+	// FretPitches holds the exact pitch of each entry of FretNotes. It's only filled for strings
+	// built from a pitch, see NewPitchedString.
+	FretPitches []music.Pitch
 }
 
 func NewString(openNote *music.Note, numOfFrets int) *String {
-	fretNotes := make([]music.Note, numOfFrets) // 0-indexed
+	return NewStringAt(openNote, 0, numOfFrets)
+}
+
+// NewStringAt builds a string that starts at startFret, with numOfFrets fret positions counting
+// its open string.
+func NewStringAt(openNote *music.Note, startFret int, numOfFrets int) *String {
+	fretNotes := make([]music.Note, max(numOfFrets, 0)) // 0-indexed
 	currNote := *openNote
 
-	for i := range numOfFrets {
+	for i := range fretNotes {
 		fretNotes[i] = currNote
 		nextNote, _ := currNote.NextHalfStepNote()
 		currNote = *nextNote
 	}
 
 	return &String{
+		StartFret: startFret,
 		FretNotes: fretNotes,
 	}
 }
 
-func StandardTuning() []*music.Note {
-	eNote, _ := music.FindNote(music.E, music.Natural)
-	bNote, _ := music.FindNote(music.B, music.Natural)
-	gNote, _ := music.FindNote(music.G, music.Natural)
-	dNote, _ := music.FindNote(music.D, music.Natural)
-	aNote, _ := music.FindNote(music.A, music.Natural)
-
-	return []*music.Note{
-		eNote,
-		bNote,
-		gNote,
-		dNote,
-		aNote,
-		eNote,
+// NewPitchedString builds a string from the exact pitch of its open string (e.g. E2 rather than
+// E), so every fret knows which octave it sounds in.
+func NewPitchedString(openPitch *music.Pitch, startFret int, numOfFrets int) *String {
+	str := NewStringAt(&openPitch.Note, startFret, numOfFrets)
+	str.FretPitches = make([]music.Pitch, len(str.FretNotes))
+
+	for i := range str.FretNotes {
+		// the octave is worked out from the MIDI number so that notes like B# land in the right one
+		pitch := music.NewPitch(&str.FretNotes[i], 0)
+		pitch.Octave = (openPitch.MIDI() + i - pitch.MIDI()) / music.PitchClassCount
+		str.FretPitches[i] = *pitch
+	}
+
+	return str
+}
+
+// LastFret returns the highest fret of the string, or StartFret-1 when it has no frets at all.
+func (s *String) LastFret() int {
+	return s.StartFret + len(s.FretNotes) - 1
+}
+
+func (s *String) HasFret(fretNumber int) bool {
+	return fretNumber >= s.StartFret && fretNumber <= s.LastFret()
+}
+
+// NoteAt returns the note at a fret numbered like the rest of the fretboard, from its nut.
+func (s *String) NoteAt(fretNumber int) (*music.Note, error) {
+	if !s.HasFret(fretNumber) {
+		return nil, fmt.Errorf("fret '%d' doesn't exist, frets go from '%d' to '%d'", fretNumber, s.StartFret, s.LastFret())
+	}
+
+	return &s.FretNotes[fretNumber-s.StartFret], nil
+}
+
+// PitchAt returns the exact pitch at a fret numbered like the rest of the fretboard, from its nut.
+func (s *String) PitchAt(fretNumber int) (*music.Pitch, error) {
+	if len(s.FretPitches) == 0 {
+		return nil, fmt.Errorf("string wasn't built from a pitch, so only its notes are known")
+	}
+
+	if !s.HasFret(fretNumber) {
+		return nil, fmt.Errorf("fret '%d' doesn't exist, frets go from '%d' to '%d'", fretNumber, s.StartFret, s.LastFret())
 	}
+
+	return &s.FretPitches[fretNumber-s.StartFret], nil
 }
 
+// FindNote returns the frets the note is found at, numbered from the nut of the fretboard.
 func (s *String) FindNote(note *music.Note) map[int]*music.Note {
 	ret := make(map[int]*music.Note)
 
-	for fretNum, currNote := range s.FretNotes {
-		if note.Equals(&currNote) {
-			ret[fretNum] = note
+	for idx, currNote := range s.FretNotes {
+		if note.EnharmonicEquals(&currNote) {
+			ret[s.StartFret+idx] = note
 		}
 	}
 
@@ -34,3 +34,55 @@ func TestString_FindNote(t *testing.T) {
 		12: eNote,
 	})
 }
+
+func TestString_StartingAboveTheNut(t *testing.T) {
+	gNote, _ := music.FindNote(music.G, music.Natural)
+	str := NewStringAt(gNote, 5, 17)
+
+	assert.Equal(t, 21, str.LastFret())
+	assert.False(t, str.HasFret(4))
+	assert.True(t, str.HasFret(5))
+	assert.False(t, str.HasFret(22))
+
+	note, err := str.NoteAt(5)
+	assert.Nil(t, err)
+	assert.True(t, note.Equals(gNote))
+
+	note, err = str.NoteAt(7)
+	assert.Nil(t, err)
+	assert.Equal(t, music.A, note.Name)
+
+	_, err = str.NoteAt(0)
+	assert.Error(t, err)
+
+	// frets are numbered from the nut of the fretboard
+	assert.Equal(t, map[int]*music.Note{
+		5:  gNote,
+		17: gNote,
+	}, str.FindNote(gNote))
+}
+
+func TestNewPitchedString(t *testing.T) {
+	openPitch, _ := music.ParsePitch("A2")
+	str := NewPitchedString(openPitch, 0, 24)
+
+	assert.Len(t, str.FretPitches, 24)
+
+	pitch, err := str.PitchAt(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "A2", pitch.String())
+
+	pitch, _ = str.PitchAt(3)
+	assert.Equal(t, "C3", pitch.String())
+
+	pitch, _ = str.PitchAt(15)
+	assert.Equal(t, "C4", pitch.String())
+
+	_, err = str.PitchAt(24)
+	assert.Error(t, err)
+
+	// strings built from a note alone don't know their octave
+	eNote, _ := music.FindNote(music.E, music.Natural)
+	_, err = NewString(eNote, 24).PitchAt(0)
+	assert.Error(t, err)
+}
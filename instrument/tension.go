@@ -0,0 +1,325 @@
+package instrument
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// gravitational acceleration in in/s², which turns unit weights in pounds into mass
+const gravityInchesPerSecondSquared = 386.4
+
+type StringGauge struct {
+	// Name uses the usual string maker codes: PL for plain steel, NW for nickel wound guitar
+	// strings and XB for nickel wound bass strings, followed by the gauge in thousandths of an
+	// inch (e.g. "PL010" or "NW046")
+	Name string
+	// Gauge is the diameter in inches
+	Gauge float64
+	Wound bool
+	// UnitWeight is the weight of the string in pounds per inch
+	UnitWeight float64
+}
+
+var stringGauges = []*StringGauge{
+	plainGauge(0.008, 0.00001418),
+	plainGauge(0.009, 0.00001794),
+	plainGauge(0.010, 0.00002215),
+	plainGauge(0.011, 0.00002680),
+	plainGauge(0.012, 0.00003190),
+	plainGauge(0.013, 0.00003744),
+	plainGauge(0.014, 0.00004342),
+	plainGauge(0.015, 0.00004984),
+	plainGauge(0.016, 0.00005671),
+	plainGauge(0.017, 0.00006402),
+	plainGauge(0.018, 0.00007177),
+	plainGauge(0.019, 0.00007997),
+	plainGauge(0.020, 0.00008861),
+	plainGauge(0.022, 0.00010722),
+	plainGauge(0.024, 0.00012760),
+	plainGauge(0.026, 0.00014975),
+	woundGauge("NW", 0.017, 0.00005524),
+	woundGauge("NW", 0.018, 0.00006215),
+	woundGauge("NW", 0.020, 0.00007495),
+	woundGauge("NW", 0.022, 0.00009184),
+	woundGauge("NW", 0.024, 0.00010857),
+	woundGauge("NW", 0.026, 0.00012671),
+	woundGauge("NW", 0.028, 0.00014666),
+	woundGauge("NW", 0.030, 0.00017236),
+	woundGauge("NW", 0.032, 0.00019347),
+	woundGauge("NW", 0.034, 0.00021590),
+	woundGauge("NW", 0.036, 0.00023964),
+	woundGauge("NW", 0.038, 0.00026471),
+	woundGauge("NW", 0.039, 0.00027932),
+	woundGauge("NW", 0.042, 0.00032279),
+	woundGauge("NW", 0.044, 0.00035182),
+	woundGauge("NW", 0.046, 0.00038216),
+	woundGauge("NW", 0.048, 0.00041382),
+	woundGauge("NW", 0.049, 0.00043014),
+	woundGauge("NW", 0.052, 0.00048109),
+	woundGauge("NW", 0.054, 0.00053838),
+	woundGauge("NW", 0.056, 0.00057598),
+	woundGauge("NW", 0.059, 0.00064191),
+	woundGauge("NW", 0.062, 0.00070697),
+	woundGauge("NW", 0.064, 0.00074984),
+	woundGauge("NW", 0.068, 0.00084386),
+	woundGauge("NW", 0.070, 0.00089015),
+	woundGauge("NW", 0.074, 0.00099976),
+	woundGauge("NW", 0.080, 0.00115805),
+	woundGauge("XB", 0.040, 0.00030400),
+	woundGauge("XB", 0.045, 0.00038500),
+	woundGauge("XB", 0.050, 0.00047500),
+	woundGauge("XB", 0.055, 0.00057500),
+	woundGauge("XB", 0.060, 0.00068400),
+	woundGauge("XB", 0.065, 0.00080300),
+	woundGauge("XB", 0.070, 0.00093100),
+	woundGauge("XB", 0.075, 0.00106900),
+	woundGauge("XB", 0.080, 0.00121600),
+	woundGauge("XB", 0.085, 0.00137300),
+	woundGauge("XB", 0.090, 0.00153900),
+	woundGauge("XB", 0.095, 0.00171500),
+	woundGauge("XB", 0.100, 0.00190000),
+	woundGauge("XB", 0.105, 0.00209500),
+	woundGauge("XB", 0.110, 0.00229900),
+	woundGauge("XB", 0.120, 0.00273600),
+	woundGauge("XB", 0.125, 0.00296900),
+	woundGauge("XB", 0.130, 0.00321100),
+	woundGauge("XB", 0.135, 0.00346300),
+}
+
+// StringSet is a named set of gauges, from string 1 to the lowest string.
+type StringSet struct {
+	Name   string
+	Gauges []string
+}
+
+var stringSets = []*StringSet{
+	{Name: "Super Light", Gauges: []string{"PL009", "PL011", "PL016", "NW024", "NW032", "NW042"}},
+	{Name: "Regular Light", Gauges: []string{"PL010", "PL013", "PL017", "NW026", "NW036", "NW046"}},
+	{Name: "Medium", Gauges: []string{"PL011", "PL014", "PL018", "NW028", "NW038", "NW049"}},
+	{Name: "Heavy", Gauges: []string{"PL012", "PL016", "NW024", "NW032", "NW042", "NW052"}},
+	{Name: "7-String Regular Light", Gauges: []string{"PL010", "PL013", "PL017", "NW026", "NW036", "NW046", "NW059"}},
+	{Name: "Bass Regular Light", Gauges: []string{"XB045", "XB065", "XB080", "XB100"}},
+	{Name: "5-String Bass Regular Light", Gauges: []string{"XB045", "XB065", "XB080", "XB100", "XB130"}},
+}
+
+type TensionLimits struct {
+	// Min is the tension in pounds below which a string feels too slack
+	Min float64
+	// Max is the tension in pounds above which a string is too tight to play comfortably
+	Max float64
+}
+
+var (
+	GuitarTensionLimits = TensionLimits{Min: 12, Max: 22}
+	BassTensionLimits   = TensionLimits{Min: 30, Max: 55}
+)
+
+type TensionStatus int
+
+const (
+	TensionOK TensionStatus = iota
+	TensionTooSlack
+	TensionTooTight
+)
+
+func (s TensionStatus) String() string {
+	switch s {
+	case TensionTooSlack:
+		return "too slack"
+	case TensionTooTight:
+		return "too tight"
+	default:
+		return "ok"
+	}
+}
+
+// StringTension is the tension of one string of a fretboard tuned to its open pitch.
+type StringTension struct {
+	StringNumber int
+	Pitch        *music.Pitch
+	Gauge        *StringGauge
+	// ScaleLength is the vibrating length of the string in inches
+	ScaleLength float64
+	// Frequency of the open string in Hz
+	Frequency float64
+	// Tension in pounds
+	Tension float64
+	Status  TensionStatus
+}
+
+func StringGauges() []*StringGauge {
+	return append([]*StringGauge{}, stringGauges...)
+}
+
+func StringSets() []*StringSet {
+	return append([]*StringSet{}, stringSets...)
+}
+
+// FindStringGauge looks up a gauge by its code (e.g. "PL010", "NW046" or "XB105") or by its
+// size in thousandths or in inches, with an optional "p" for plain or "w" for wound (e.g. "10",
+// ".010", "26w"). Without one, gauges under .020 are taken as plain and the others as wound,
+// preferring guitar strings over bass strings of the same size.
+func FindStringGauge(name string) (*StringGauge, error) {
+	return findStringGauge(name, false)
+}
+
+func findStringGauge(name string, preferBass bool) (*StringGauge, error) {
+	normalizedName := strings.ToUpper(strings.TrimSpace(name))
+
+	for _, gauge := range stringGauges {
+		if gauge.Name == normalizedName {
+			return gauge, nil
+		}
+	}
+
+	sizeText := strings.TrimRightFunc(normalizedName, unicode.IsLetter)
+	suffix := normalizedName[len(sizeText):]
+
+	size, err := strconv.ParseFloat(sizeText, 64)
+	if err != nil || (suffix != "" && suffix != "P" && suffix != "W") {
+		return nil, fmt.Errorf("string gauge '%s' isn't valid, expected something like '10', '.010', '26w' or 'NW026'", name)
+	}
+
+	// sizes are usually written in thousandths of an inch
+	if size >= 1 {
+		size /= 1000
+	}
+
+	wound := suffix == "W" || (suffix == "" && size >= 0.020)
+	var found *StringGauge
+	for _, gauge := range stringGauges {
+		if gauge.Wound == wound && math.Abs(gauge.Gauge-size) < 0.00005 {
+			if found == nil || (preferBass && isBassGauge(gauge)) {
+				found = gauge
+			}
+		}
+	}
+
+	if found != nil {
+		return found, nil
+	}
+
+	return nil, fmt.Errorf("string gauge '%s' not found", name)
+}
+
+// ParseStringGauges parses the name of a string set (e.g. "regular light") or its gauges from
+// string 1 to the lowest string, the way string sets are sold (e.g. "10 13 17 26 36 46" or
+// "10-13-17-26w-36-46"). Sets with bass strings in them (e.g. "45 65 80 100") use bass strings
+// for every size that comes in both.
+func ParseStringGauges(gauges string) ([]*StringGauge, error) {
+	names := strings.FieldsFunc(gauges, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '-'
+	})
+
+	normalizedName := normalizeName(gauges)
+	for _, set := range stringSets {
+		if normalizeName(set.Name) == normalizedName {
+			names = set.Gauges
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("string gauges are empty")
+	}
+
+	parsedGauges := make([]*StringGauge, len(names))
+	preferBass := false
+	for i, name := range names {
+		gauge, err := FindStringGauge(name)
+		if err != nil {
+			return nil, err
+		}
+		parsedGauges[i] = gauge
+		preferBass = preferBass || isBassGauge(gauge)
+	}
+
+	if preferBass {
+		for i, name := range names {
+			parsedGauges[i], _ = findStringGauge(name, true)
+		}
+	}
+
+	return parsedGauges, nil
+}
+
+// Tension returns the tension in pounds of a string with a unit weight in pounds per inch,
+// vibrating over scaleLength inches at a frequency in Hz.
+func Tension(unitWeight float64, scaleLength float64, frequency float64) float64 {
+	return unitWeight * math.Pow(2*scaleLength*frequency, 2) / gravityInchesPerSecondSquared
+}
+
+// Tensions works out the tension of every string when tuned to the fretboard's tuning, given the
+// gauges from string 1 to the lowest string. Short strings (e.g. the 5th string of a banjo) only
+// vibrate from their own nut, and a capo doesn't change the tension, as it shortens the string
+// as much as it raises its pitch.
+func (f *Fretboard) Tensions(gauges []*StringGauge, limits TensionLimits) ([]StringTension, error) {
+	if len(gauges) != len(f.Strings) {
+		return nil, fmt.Errorf("fretboard has %d strings but %d gauges were given", len(f.Strings), len(gauges))
+	}
+
+	tensions := make([]StringTension, len(f.Strings))
+	for strIdx, str := range f.Strings {
+		var scaleLength float64
+		switch {
+		case f.Multiscale != nil:
+			scaleLength = f.Multiscale.ScaleLength(strIdx+1, len(f.Strings))
+		case f.ScaleLength > 0:
+			scaleLength = f.ScaleLength
+		default:
+			return nil, fmt.Errorf("fretboard doesn't have a scale length")
+		}
+		scaleLength -= FretDistance(scaleLength, str.StartFret)
+
+		pitch, err := f.openPitch(strIdx + 1)
+		if err != nil {
+			return nil, err
+		}
+		frequency := pitch.Frequency(music.StandardA4Frequency)
+		tension := Tension(gauges[strIdx].UnitWeight, scaleLength, frequency)
+
+		status := TensionOK
+		switch {
+		case tension < limits.Min:
+			status = TensionTooSlack
+		case limits.Max > 0 && tension > limits.Max:
+			status = TensionTooTight
+		}
+
+		tensions[strIdx] = StringTension{
+			StringNumber: strIdx + 1,
+			Pitch:        pitch,
+			Gauge:        gauges[strIdx],
+			ScaleLength:  scaleLength,
+			Frequency:    frequency,
+			Tension:      tension,
+			Status:       status,
+		}
+	}
+
+	return tensions, nil
+}
+
+func isBassGauge(gauge *StringGauge) bool {
+	return strings.HasPrefix(gauge.Name, "XB")
+}
+
+func plainGauge(gauge float64, unitWeight float64) *StringGauge {
+	return &StringGauge{
+		Name:       fmt.Sprintf("PL%03d", int(math.Round(gauge*1000))),
+		Gauge:      gauge,
+		UnitWeight: unitWeight,
+	}
+}
+
+func woundGauge(prefix string, gauge float64, unitWeight float64) *StringGauge {
+	return &StringGauge{
+		Name:       fmt.Sprintf("%s%03d", prefix, int(math.Round(gauge*1000))),
+		Gauge:      gauge,
+		Wound:      true,
+		UnitWeight: unitWeight,
+	}
+}
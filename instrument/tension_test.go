@@ -0,0 +1,112 @@
+package instrument
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTension(t *testing.T) {
+	// a .046 wound string tuned to E2 on a 25.5" scale
+	assert.InDelta(t, 17.5, Tension(0.00038216, 25.5, 82.41), 0.05)
+}
+
+func TestStringGauge_FindStringGauge(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"PL010", "PL010"},
+		{"nw046", "NW046"},
+		{"10", "PL010"},
+		{".010", "PL010"},
+		{"0.013", "PL013"},
+		{"17", "PL017"},
+		{"17w", "NW017"},
+		{"20", "NW020"},
+		{"20p", "PL020"},
+		{"46", "NW046"},
+		{"80", "NW080"},
+		{"105", "XB105"},
+	}
+
+	for _, testCase := range testCases {
+		gauge, err := FindStringGauge(testCase.name)
+		if assert.Nil(t, err, testCase.name) {
+			assert.Equal(t, testCase.expected, gauge.Name, testCase.name)
+		}
+	}
+
+	for _, name := range []string{"", "ten", "10x", "11w", "300"} {
+		_, err := FindStringGauge(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestStringGauge_ParseStringGauges(t *testing.T) {
+	gaugeNames := func(gauges []*StringGauge) []string {
+		names := make([]string, len(gauges))
+		for i, gauge := range gauges {
+			names[i] = gauge.Name
+		}
+		return names
+	}
+
+	gauges, err := ParseStringGauges("10-13-17-26-36-46")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"PL010", "PL013", "PL017", "NW026", "NW036", "NW046"}, gaugeNames(gauges))
+
+	gauges, err = ParseStringGauges("Regular Light")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"PL010", "PL013", "PL017", "NW026", "NW036", "NW046"}, gaugeNames(gauges))
+
+	// sizes made for both guitars and basses are bass strings in a bass set
+	gauges, err = ParseStringGauges("45, 65, 80, 100")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"XB045", "XB065", "XB080", "XB100"}, gaugeNames(gauges))
+
+	_, err = ParseStringGauges("")
+	assert.Error(t, err)
+
+	_, err = ParseStringGauges("10 13 x")
+	assert.Error(t, err)
+}
+
+func TestFretboard_Tensions(t *testing.T) {
+	profile, _ := FindProfile("guitar")
+	gauges, _ := ParseStringGauges("regular light")
+
+	tensions, err := profile.NewFretboard().Tensions(gauges, profile.TensionLimits)
+	assert.Nil(t, err)
+
+	expected := []float64{16.2, 15.4, 16.6, 18.4, 19.5, 17.5}
+	for i, tension := range tensions {
+		assert.Equal(t, i+1, tension.StringNumber)
+		assert.InDelta(t, expected[i], tension.Tension, 0.05)
+		assert.Equal(t, TensionOK, tension.Status)
+	}
+
+	// the low string gets too slack in drop C
+	profile.Tuning, _ = ParseTuning("drop c")
+	tensions, _ = profile.NewFretboard().Tensions(gauges, profile.TensionLimits)
+	assert.Equal(t, TensionTooSlack, tensions[5].Status)
+	assert.Equal(t, "too slack", tensions[5].Status.String())
+	assert.Equal(t, TensionOK, tensions[4].Status)
+
+	// and the high string too tight a whole step up
+	profile.Tuning, _ = ParseTuning("F#2 B2 E3 A3 C#4 F#4")
+	tensions, _ = profile.NewFretboard().Tensions(gauges, TensionLimits{Min: 12, Max: 20})
+	assert.Equal(t, TensionTooTight, tensions[0].Status)
+
+	// a short string only vibrates from its own nut
+	banjo, _ := FindProfile("banjo")
+	banjoGauges, _ := ParseStringGauges("10 12 16 24w 10")
+	tensions, err = banjo.NewFretboard().Tensions(banjoGauges, banjo.TensionLimits)
+	assert.Nil(t, err)
+	assert.InDelta(t, banjo.ScaleLength-FretDistance(banjo.ScaleLength, 5), tensions[4].ScaleLength, 1e-9)
+
+	_, err = profile.NewFretboard().Tensions(banjoGauges, profile.TensionLimits)
+	assert.Error(t, err)
+
+	_, err = NewFretboard(24, StandardTuning()).Tensions(gauges, GuitarTensionLimits)
+	assert.Error(t, err)
+}
@@ -0,0 +1,199 @@
+package instrument
+
+import (
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"strings"
+	"unicode"
+)
+
+// the lowest string of a tuning written without octaves is placed near this pitch, the low E of
+// a guitar
+var inferredLowestPitch = music.Pitch{Note: music.Note{Name: music.E, Symbol: music.Natural}, Octave: 2}
+
+type Tuning struct {
+	Name string
+	// Pitches of the open strings in string order, so string 1 (the highest pitched) comes first
+	Pitches []*music.Pitch
+}
+
+var tunings = []*Tuning{
+	mustTuning("Standard", "E2 A2 D3 G3 B3 E4"),
+	mustTuning("Drop D", "D2 A2 D3 G3 B3 E4"),
+	mustTuning("Drop C", "C2 G2 C3 F3 A3 D4"),
+	mustTuning("DADGAD", "D2 A2 D3 G3 A3 D4"),
+	mustTuning("Open G", "D2 G2 D3 G3 B3 D4"),
+	mustTuning("Open D", "D2 A2 D3 F#3 A3 D4"),
+	mustTuning("Open E", "E2 B2 E3 G#3 B3 E4"),
+	mustTuning("Half Step Down", "Eb2 Ab2 Db3 Gb3 Bb3 Eb4"),
+	mustTuning("All Fourths", "E2 A2 D3 G3 C4 F4"),
+	// the lower four strings are replaced by thinner ones tuned an octave higher
+	mustTuning("Nashville", "E3 A3 D4 G4 B3 E4"),
+}
+
+func StandardTuning() *Tuning {
+	tuning, _ := FindTuning("standard")
+	return tuning
+}
+
+func Tunings() []*Tuning {
+	return append([]*Tuning{}, tunings...)
+}
+
+// FindTuning looks up a named tuning, ignoring case, spaces and hyphens (e.g. "drop-d").
+func FindTuning(name string) (*Tuning, error) {
+	normalizedName := normalizeName(name)
+
+	for _, tuning := range tunings {
+		if normalizeName(tuning.Name) == normalizedName {
+			return tuning.clone(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("tuning '%s' not found", name)
+}
+
+// ParseTuning accepts the name of a known tuning or the notes of the open strings from the lowest
+// to the highest pitched string, such as "EADGBE", "D A D G B E" or "E2 A2 D3 G3 B3 E4". Missing
+// octaves are inferred by placing the lowest string near E2 and every other string just above
+// the string before it. Note letters have to be uppercase when the notes aren't separated.
+func ParseTuning(tuning string) (*Tuning, error) {
+	if namedTuning, err := FindTuning(tuning); err == nil {
+		return namedTuning, nil
+	}
+
+	return parseTuningNotes(tuning)
+}
+
+func parseTuningNotes(tuning string) (*Tuning, error) {
+	tokens := strings.FieldsFunc(tuning, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+	if len(tokens) == 1 {
+		tokens = splitCompactTuning(tokens[0])
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("tuning is empty")
+	}
+
+	// tunings are written from the lowest string, while strings are numbered from the highest
+	pitches := make([]*music.Pitch, len(tokens))
+	var previous *music.Pitch
+
+	for i, token := range tokens {
+		pitch, err := parseTuningPitch(token, previous)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing tuning '%s': %v", tuning, err)
+		}
+
+		pitches[len(tokens)-1-i] = pitch
+		previous = pitch
+	}
+
+	return NewTuning(strings.Join(tokens, " "), pitches)
+}
+
+func NewTuning(name string, pitches []*music.Pitch) (*Tuning, error) {
+	if len(pitches) == 0 {
+		return nil, fmt.Errorf("tuning needs at least one string")
+	}
+
+	return &Tuning{
+		Name:    name,
+		Pitches: pitches,
+	}, nil
+}
+
+// Notes returns the notes of the open strings in string order.
+func (t *Tuning) Notes() []*music.Note {
+	notes := make([]*music.Note, len(t.Pitches))
+	for i, pitch := range t.Pitches {
+		note := pitch.Note
+		notes[i] = &note
+	}
+
+	return notes
+}
+
+func (t *Tuning) StringCount() int {
+	return len(t.Pitches)
+}
+
+func (t *Tuning) String() string {
+	pitchNames := make([]string, len(t.Pitches))
+	for i, pitch := range t.Pitches {
+		pitchNames[len(t.Pitches)-1-i] = pitch.String()
+	}
+
+	return fmt.Sprintf("%s (%s)", t.Name, strings.Join(pitchNames, " "))
+}
+
+func (t *Tuning) clone() *Tuning {
+	pitches := make([]*music.Pitch, len(t.Pitches))
+	for i, pitch := range t.Pitches {
+		pitches[i] = music.NewPitch(&pitch.Note, pitch.Octave)
+	}
+
+	return &Tuning{
+		Name:    t.Name,
+		Pitches: pitches,
+	}
+}
+
+// parseTuningPitch parses a note with an optional octave. Without an octave, the note is placed
+// just above the previous string or near E2 for the first string. Tunings are always written with
+// English note names, whatever the default naming system is, like chord symbols.
+func parseTuningPitch(token string, previous *music.Pitch) (*music.Pitch, error) {
+	if pitch, err := music.EnglishNaming.ParsePitch(token); err == nil {
+		return pitch, nil
+	}
+
+	note, err := music.EnglishNaming.ParseNote(token)
+	if err != nil {
+		return nil, err
+	}
+
+	pitch := music.NewPitch(note, 0)
+	if previous == nil {
+		// closest pitch to E2, going at most 6 semitones down
+		for pitch.MIDI() < inferredLowestPitch.MIDI()-music.PitchClassCount/2 {
+			pitch.Octave++
+		}
+		return pitch, nil
+	}
+
+	for pitch.MIDI() <= previous.MIDI() {
+		pitch.Octave++
+	}
+
+	return pitch, nil
+}
+
+// splitCompactTuning splits tunings written without separators (e.g. "EbAbDbGbBbEb" or
+// "D2A2D3G3B3E4") at every uppercase letter.
+func splitCompactTuning(tuning string) []string {
+	tokens := make([]string, 0)
+	for _, r := range tuning {
+		if unicode.IsUpper(r) || len(tokens) == 0 {
+			tokens = append(tokens, "")
+		}
+		tokens[len(tokens)-1] += string(r)
+	}
+
+	return tokens
+}
+
+func normalizeName(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(name))
+}
+
+func mustTuning(name string, pitches string) *Tuning {
+	tuning, err := parseTuningNotes(pitches)
+	if err != nil {
+		panic(fmt.Sprintf("tuning '%s' is invalid: %v", name, err))
+	}
+
+	tuning.Name = name
+	return tuning
+}
@@ -0,0 +1,121 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func pitchNames(pitches []*music.Pitch) []string {
+	names := make([]string, len(pitches))
+	for i, pitch := range pitches {
+		names[i] = pitch.String()
+	}
+
+	return names
+}
+
+func TestTuning_StandardTuning(t *testing.T) {
+	tuning := StandardTuning()
+
+	assert.Equal(t, "Standard", tuning.Name)
+	assert.Equal(t, 6, tuning.StringCount())
+	assert.Equal(t, []string{"E4", "B3", "G3", "D3", "A2", "E2"}, pitchNames(tuning.Pitches))
+	assert.Equal(t, "Standard (E2 A2 D3 G3 B3 E4)", tuning.String())
+
+	// every call returns its own copy
+	tuning.Pitches[0].Octave = 5
+	assert.Equal(t, 4, StandardTuning().Pitches[0].Octave)
+}
+
+func TestTuning_FindTuning(t *testing.T) {
+	testCases := map[string]string{
+		"drop d":         "Drop D (D2 A2 D3 G3 B3 E4)",
+		"Drop-C":         "Drop C (C2 G2 C3 F3 A3 D4)",
+		"dadgad":         "DADGAD (D2 A2 D3 G3 A3 D4)",
+		"open g":         "Open G (D2 G2 D3 G3 B3 D4)",
+		"OPEN D":         "Open D (D2 A2 D3 F#3 A3 D4)",
+		"open_e":         "Open E (E2 B2 E3 G#3 B3 E4)",
+		"half step down": "Half Step Down (Eb2 Ab2 Db3 Gb3 Bb3 Eb4)",
+		"all fourths":    "All Fourths (E2 A2 D3 G3 C4 F4)",
+		"nashville":      "Nashville (E3 A3 D4 G4 B3 E4)",
+	}
+
+	for name, expected := range testCases {
+		tuning, err := FindTuning(name)
+		if assert.Nil(t, err, name) {
+			assert.Equal(t, expected, tuning.String())
+		}
+	}
+
+	_, err := FindTuning("open z")
+	assert.Error(t, err)
+
+	assert.Len(t, Tunings(), 10)
+}
+
+func TestTuning_ParseTuning(t *testing.T) {
+	testCases := map[string][]string{
+		"EADGBE":             {"E4", "B3", "G3", "D3", "A2", "E2"},
+		"D A D G B E":        {"E4", "B3", "G3", "D3", "A2", "D2"},
+		"E2 A2 D3 G3 B3 E4":  {"E4", "B3", "G3", "D3", "A2", "E2"},
+		"D2A2D3G3B3E4":       {"E4", "B3", "G3", "D3", "A2", "D2"},
+		"EbAbDbGbBbEb":       {"Eb4", "Bb3", "Gb3", "Db3", "Ab2", "Eb2"},
+		"C,G,C,F,A,D":        {"D4", "A3", "F3", "C3", "G2", "C2"},
+		"BEADGBE":            {"E4", "B3", "G3", "D3", "A2", "E2", "B1"},
+		"E A D G":            {"G3", "D3", "A2", "E2"},
+		"E1 A1 D2 G2":        {"G2", "D2", "A1", "E1"},
+		"G4 C4 E4 A4":        {"A4", "E4", "C4", "G4"},
+		"Open G":             {"D4", "B3", "G3", "D3", "G2", "D2"},
+		"E2 A D G3 B E":      {"E4", "B3", "G3", "D3", "A2", "E2"},
+		"F♯1 B1 E2 A2 D3 G3": {"G3", "D3", "A2", "E2", "B1", "F#1"},
+		// the first string goes at most 6 semitones below E2
+		"Bb Eb Ab Db F Bb": {"Bb3", "F3", "Db3", "Ab2", "Eb2", "Bb1"},
+		"A D G C E A":      {"A4", "E4", "C4", "G3", "D3", "A2"},
+	}
+
+	for input, expected := range testCases {
+		tuning, err := ParseTuning(input)
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected, pitchNames(tuning.Pitches), input)
+		}
+	}
+
+	for _, invalid := range []string{"", "EADGBH", "E A D X"} {
+		_, err := ParseTuning(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestTuning_ParseTuning_WithNamingSystem(t *testing.T) {
+	defer music.SetDefaultNamingSystem(music.EnglishNaming)
+
+	// tunings are written with English names, so B is still B and not Bb in German
+	for _, namingSystem := range []*music.NamingSystem{music.GermanNaming, music.SolfegeNaming} {
+		music.SetDefaultNamingSystem(namingSystem)
+
+		for _, input := range []string{"E A D G B E", "EADGBE", "E2 A2 D3 G3 B3 E4"} {
+			tuning, err := ParseTuning(input)
+			if assert.Nil(t, err, input) {
+				midiNumbers := make([]int, len(tuning.Pitches))
+				for i, pitch := range tuning.Pitches {
+					midiNumbers[i] = pitch.MIDI()
+				}
+				assert.Equal(t, []int{64, 59, 55, 50, 45, 40}, midiNumbers, "%s in %s", input, namingSystem.Name)
+			}
+		}
+	}
+}
+
+func TestTuning_NewFretboard(t *testing.T) {
+	tuning, _ := ParseTuning("drop d")
+	fretboard := NewFretboard(24, tuning)
+
+	note, err := fretboard.GetNoteAt(6, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, music.D, note.Name)
+
+	note, _ = fretboard.GetNoteAt(6, 2)
+	assert.Equal(t, music.E, note.Name)
+	assert.Equal(t, tuning, fretboard.Tuning)
+}
@@ -0,0 +1,423 @@
+package instrument
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MutedString is the fret of the strings that aren't played in a voicing.
+const MutedString = -1
+
+type VoicingFamily int
+
+const (
+	// AnyVoicing doesn't care how the notes are stacked
+	AnyVoicing VoicingFamily = iota
+	// CloseVoicing plays every note once, all of them within an octave
+	CloseVoicing
+	// Drop2Voicing is a close voicing with its second highest note dropped an octave
+	Drop2Voicing
+	// Drop3Voicing is a close voicing with its third highest note dropped an octave
+	Drop3Voicing
+)
+
+var voicingFamilyNames = map[VoicingFamily]string{
+	AnyVoicing:   "any",
+	CloseVoicing: "close",
+	Drop2Voicing: "drop 2",
+	Drop3Voicing: "drop 3",
+}
+
+type VoicingOptions struct {
+	// MaxFretSpan is how many frets the fretted notes can cover, 4 (e.g. frets 5 to 8) when 0
+	MaxFretSpan int
+	// MaxFingers is how many fingers a voicing can need, 4 when 0. A barre only needs one finger.
+	MaxFingers int
+	// RootInBass only keeps the voicings with the bass note on the lowest string played
+	RootInBass bool
+	// NoMutedInnerStrings only keeps the voicings where the strings played are next to each other
+	NoMutedInnerStrings bool
+	Family              VoicingFamily
+}
+
+// Voicing is a way of playing a chord with one note, at most, on every string.
+type Voicing struct {
+	// Frets has the fret played on every string, from string 1, or MutedString. Frets are counted
+	// from the nut.
+	Frets []int
+	// Fingers has the finger suggested for every string, from 1 (index) to 4 (pinky), or 0 for
+	// open, capoed and muted strings
+	Fingers []int
+	// Barre is set when the index finger holds down more than one string
+	Barre bool
+	// Cost ranks voicings by how hard they are to play, easier ones costing less
+	Cost float64
+}
+
+func (f VoicingFamily) String() string {
+	return voicingFamilyNames[f]
+}
+
+// ParseVoicingFamily parses "any", "close", "drop 2" or "drop 3" (e.g. "drop2" or "Drop-3").
+func ParseVoicingFamily(name string) (VoicingFamily, error) {
+	for family, familyName := range voicingFamilyNames {
+		if normalizeName(familyName) == normalizeName(name) {
+			return family, nil
+		}
+	}
+
+	return AnyVoicing, fmt.Errorf("voicing family '%s' not found, expected any, close, drop 2 or drop 3", name)
+}
+
+// ChordVoicings works like Voicings with the notes of a chord. Slash chords always have their
+// bass note on the lowest string played.
+func (f *Fretboard) ChordVoicings(chord *music.Chord, options VoicingOptions) ([]Voicing, error) {
+	notes := chord.Notes()
+	if chord.IsSlashChord() {
+		options.RootInBass = true
+		if !slices.ContainsFunc(notes, chord.Bass.EnharmonicEquals) {
+			notes = append(notes, chord.Bass)
+		}
+	}
+
+	return f.Voicings(notes, chord.Bass, options)
+}
+
+// Voicings returns the playable voicings with every one of the notes, and nothing else, ranked
+// from the easiest one to play. The bass note is the one RootInBass wants on the lowest string
+// played. Frets behind the capo aren't used, and strings held down by the capo are played like
+// open strings.
+func (f *Fretboard) Voicings(notes []*music.Note, bass *music.Note, options VoicingOptions) ([]Voicing, error) {
+	if len(notes) == 0 {
+		return nil, fmt.Errorf("voicings need at least one note")
+	}
+
+	if options.MaxFretSpan == 0 {
+		options.MaxFretSpan = 4
+	}
+	if options.MaxFingers == 0 {
+		options.MaxFingers = 4
+	}
+	if options.MaxFretSpan < 0 || options.MaxFingers < 0 {
+		return nil, fmt.Errorf("fret span '%d' and fingers '%d' can't be negative", options.MaxFretSpan, options.MaxFingers)
+	}
+
+	pitchClasses := make([]music.PitchClass, 0, len(notes))
+	for _, note := range notes {
+		pitchClass, err := note.PitchClass()
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(pitchClasses, pitchClass) {
+			pitchClasses = append(pitchClasses, pitchClass)
+		}
+	}
+
+	bassPitchClass, err := bass.PitchClass()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pitchClasses) > len(f.Strings) {
+		return nil, fmt.Errorf("%d notes don't fit on %d strings", len(pitchClasses), len(f.Strings))
+	}
+
+	// frets with one of the notes on every string, from the lowest one that can be played
+	candidates := make([][]int, len(f.Strings))
+	for strIdx, str := range f.Strings {
+		for fretNumber := f.lowestFret(strIdx + 1); fretNumber <= str.LastFret(); fretNumber++ {
+			pitch, err := str.PitchAt(fretNumber)
+			if err != nil {
+				return nil, fmt.Errorf("string '%d': %v", strIdx+1, err)
+			}
+
+			if slices.Contains(pitchClasses, music.PitchClass(pitch.MIDI()%music.PitchClassCount)) {
+				candidates[strIdx] = append(candidates[strIdx], fretNumber)
+			}
+		}
+	}
+
+	voicings := make([]Voicing, 0)
+	frets := make([]int, len(f.Strings))
+
+	var search func(strIdx int, lowestFretted int, highestFretted int)
+	search = func(strIdx int, lowestFretted int, highestFretted int) {
+		if strIdx == len(f.Strings) {
+			if voicing, ok := f.newVoicing(frets, pitchClasses, bassPitchClass, options); ok {
+				voicings = append(voicings, voicing)
+			}
+			return
+		}
+
+		frets[strIdx] = MutedString
+		search(strIdx+1, lowestFretted, highestFretted)
+
+		for _, fretNumber := range candidates[strIdx] {
+			frets[strIdx] = fretNumber
+			if fretNumber == f.lowestFret(strIdx+1) {
+				search(strIdx+1, lowestFretted, highestFretted)
+				continue
+			}
+
+			lowest, highest := min(lowestFretted, fretNumber), max(highestFretted, fretNumber)
+			if highest-lowest < options.MaxFretSpan {
+				search(strIdx+1, lowest, highest)
+			}
+		}
+	}
+	search(0, f.FretCount(), 0)
+
+	if len(voicings) == 0 {
+		return nil, fmt.Errorf("no playable voicings found")
+	}
+
+	slices.SortStableFunc(voicings, func(a, b Voicing) int {
+		return cmp.Compare(a.Cost, b.Cost)
+	})
+
+	return voicings, nil
+}
+
+// Positions returns the strings played, from the lowest one.
+func (v *Voicing) Positions() []Position {
+	positions := make([]Position, 0, len(v.Frets))
+	for strIdx := len(v.Frets) - 1; strIdx >= 0; strIdx-- {
+		if v.Frets[strIdx] != MutedString {
+			positions = append(positions, Position{StringNumber: strIdx + 1, FretNumber: v.Frets[strIdx]})
+		}
+	}
+
+	return positions
+}
+
+// String writes the frets the way chord charts do, from the lowest string and with an x for the
+// muted ones (e.g. "x32010"). Frets are separated by dashes when any of them has two digits
+// (e.g. "x-10-12-12-12-10").
+func (v *Voicing) String() string {
+	return chordChart(v.Frets, v.Frets)
+}
+
+// FingerChart writes the fingers like String writes the frets (e.g. "x32010" is fingered
+// "x32010" as well, while "133211" is fingered "134211").
+func (v *Voicing) FingerChart() string {
+	return chordChart(v.Fingers, v.Frets)
+}
+
+// newVoicing checks the frets against the options, working out the fingers and the cost of the
+// voicing when they're playable.
+func (f *Fretboard) newVoicing(frets []int, pitchClasses []music.PitchClass, bassPitchClass music.PitchClass, options VoicingOptions) (Voicing, bool) {
+	// pitches from the lowest string played
+	midiNumbers := make([]int, 0, len(frets))
+	lowestStrIdx, highestStrIdx := -1, -1
+	for strIdx := len(frets) - 1; strIdx >= 0; strIdx-- {
+		if frets[strIdx] == MutedString {
+			continue
+		}
+
+		// the frets come from the candidates, whose pitches are known
+		pitch, _ := f.Strings[strIdx].PitchAt(frets[strIdx])
+		midiNumbers = append(midiNumbers, pitch.MIDI())
+		if lowestStrIdx == -1 {
+			lowestStrIdx = strIdx
+		}
+		highestStrIdx = strIdx
+	}
+
+	if len(midiNumbers) == 0 {
+		return Voicing{}, false
+	}
+
+	for _, pitchClass := range pitchClasses {
+		if !slices.ContainsFunc(midiNumbers, func(midiNumber int) bool {
+			return midiNumber%music.PitchClassCount == int(pitchClass)
+		}) {
+			return Voicing{}, false
+		}
+	}
+
+	if options.RootInBass && midiNumbers[0]%music.PitchClassCount != int(bassPitchClass) {
+		return Voicing{}, false
+	}
+
+	mutedStrings, mutedInnerStrings := 0, 0
+	for strIdx, fretNumber := range frets {
+		if fretNumber == MutedString {
+			mutedStrings++
+			if strIdx > highestStrIdx && strIdx < lowestStrIdx {
+				mutedInnerStrings++
+			}
+		}
+	}
+
+	if options.NoMutedInnerStrings && mutedInnerStrings > 0 {
+		return Voicing{}, false
+	}
+
+	if !matchesVoicingFamily(midiNumbers, options.Family) {
+		return Voicing{}, false
+	}
+
+	fingers, barre, ok := f.voicingFingers(frets, options.MaxFingers)
+	if !ok {
+		return Voicing{}, false
+	}
+
+	// open strings count as their nut (or the capo) in the stretch, so shapes fretted high up the
+	// neck with open strings among them don't look as easy as the shapes they come from
+	lowestFretted, lowestPlayed, highestFretted := f.FretCount(), f.FretCount(), 0
+	fingerCount := 0
+	for strIdx, finger := range fingers {
+		if frets[strIdx] != MutedString {
+			lowestPlayed = min(lowestPlayed, frets[strIdx])
+		}
+
+		if finger != 0 {
+			lowestFretted = min(lowestFretted, frets[strIdx])
+			highestFretted = max(highestFretted, frets[strIdx])
+			fingerCount = max(fingerCount, finger)
+		}
+	}
+
+	// muted strings in the middle of the voicing need a finger to damp them, so they cost more
+	// than the ones on the edges. The position up the neck mostly breaks ties, so open voicings
+	// come first, and inversions come after root position voicings.
+	cost := 0.5*float64(fingerCount) + float64(mutedStrings) + 1.5*float64(mutedInnerStrings)
+	if fingerCount > 0 {
+		cost += 0.5*float64(highestFretted-lowestPlayed) + 0.1*float64(lowestFretted)
+	}
+	if barre {
+		cost += 1
+	}
+	if midiNumbers[0]%music.PitchClassCount != int(bassPitchClass) {
+		cost += 1.5
+	}
+
+	return Voicing{
+		Frets:   slices.Clone(frets),
+		Fingers: fingers,
+		Barre:   barre,
+		Cost:    cost,
+	}, true
+}
+
+// voicingFingers gives every fretted note its own finger, going up the neck from the lowest
+// string. When that takes more than maxFingers fingers, the index finger bars the lowest fret
+// if the strings under it are all fretted.
+func (f *Fretboard) voicingFingers(frets []int, maxFingers int) ([]int, bool, bool) {
+	fretted := make([]int, 0, len(frets))
+	for strIdx, fretNumber := range frets {
+		if fretNumber != MutedString && fretNumber != f.lowestFret(strIdx+1) {
+			fretted = append(fretted, strIdx)
+		}
+	}
+
+	fingers := make([]int, len(frets))
+	if len(fretted) == 0 {
+		return fingers, false, true
+	}
+
+	slices.SortStableFunc(fretted, func(a, b int) int {
+		if frets[a] != frets[b] {
+			return frets[a] - frets[b]
+		}
+		return b - a
+	})
+
+	barre := false
+	if len(fretted) > min(maxFingers, 4) {
+		barreFret := frets[fretted[0]]
+		barreStrings := make([]int, 0)
+		for _, strIdx := range fretted {
+			if frets[strIdx] == barreFret {
+				barreStrings = append(barreStrings, strIdx)
+			}
+		}
+
+		barre = len(barreStrings) > 1
+		for strIdx := slices.Min(barreStrings); barre && strIdx <= slices.Max(barreStrings); strIdx++ {
+			barre = frets[strIdx] >= barreFret && frets[strIdx] != f.lowestFret(strIdx+1)
+		}
+
+		if barre {
+			for _, strIdx := range barreStrings {
+				fingers[strIdx] = 1
+			}
+			fretted = fretted[len(barreStrings):]
+		}
+	}
+
+	finger := 1
+	if barre {
+		finger = 2
+	}
+	for _, strIdx := range fretted {
+		fingers[strIdx] = finger
+		finger++
+	}
+
+	return fingers, barre, finger-1 <= min(maxFingers, 4)
+}
+
+// matchesVoicingFamily tells whether the pitches, from the lowest string played, are stacked the
+// way the family stacks them.
+func matchesVoicingFamily(midiNumbers []int, family VoicingFamily) bool {
+	sorted := slices.Sorted(slices.Values(midiNumbers))
+
+	switch family {
+	case CloseVoicing:
+		return isCloseVoicing(sorted)
+	case Drop2Voicing:
+		return isDropVoicing(sorted, 2)
+	case Drop3Voicing:
+		return isDropVoicing(sorted, 3)
+	default:
+		return true
+	}
+}
+
+// isCloseVoicing tells whether the sorted pitches are all different and within an octave.
+func isCloseVoicing(sorted []int) bool {
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] == sorted[i-1] {
+			return false
+		}
+	}
+
+	return sorted[len(sorted)-1]-sorted[0] < music.PitchClassCount
+}
+
+// isDropVoicing tells whether raising the lowest of the sorted pitches an octave gives a close
+// voicing where it's the dropped-th note from the top.
+func isDropVoicing(sorted []int, dropped int) bool {
+	if len(sorted) <= dropped {
+		return false
+	}
+
+	raisedNote := sorted[0] + music.PitchClassCount
+	raised := slices.Sorted(slices.Values(append(slices.Clone(sorted[1:]), raisedNote)))
+
+	return isCloseVoicing(raised) && raised[len(raised)-dropped] == raisedNote
+}
+
+// chordChart writes values for every string from the lowest one, with an x for muted strings.
+func chordChart(values []int, frets []int) string {
+	separator := ""
+	if slices.Max(values) > 9 {
+		separator = "-"
+	}
+
+	chart := make([]string, 0, len(values))
+	for strIdx := len(values) - 1; strIdx >= 0; strIdx-- {
+		if frets[strIdx] == MutedString {
+			chart = append(chart, "x")
+		} else {
+			chart = append(chart, strconv.Itoa(values[strIdx]))
+		}
+	}
+
+	return strings.Join(chart, separator)
+}
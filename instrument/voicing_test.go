@@ -0,0 +1,193 @@
+package instrument
+
+import (
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func TestFretboard_ChordVoicings(t *testing.T) {
+	fretboard := NewFretboard(16, StandardTuning())
+
+	chord, _ := music.ParseChord("C")
+	voicings, err := fretboard.ChordVoicings(chord, VoicingOptions{RootInBass: true})
+	assert.Nil(t, err)
+
+	// the open chord is the easiest one
+	assert.Equal(t, []int{0, 1, 0, 2, 3, MutedString}, voicings[0].Frets)
+	assert.Equal(t, "x32010", voicings[0].String())
+	assert.Equal(t, "x32010", voicings[0].FingerChart())
+	assert.False(t, voicings[0].Barre)
+
+	for i, voicing := range voicings {
+		positions := voicing.Positions()
+		note, _ := fretboard.GetNoteAt(positions[0].StringNumber, positions[0].FretNumber)
+		assert.True(t, note.Equals(chord.Root), voicing.String())
+
+		if i > 0 {
+			assert.GreaterOrEqual(t, voicing.Cost, voicings[i-1].Cost)
+		}
+	}
+
+	// the index finger bars the F chord
+	chord, _ = music.ParseChord("F")
+	voicings, err = fretboard.ChordVoicings(chord, VoicingOptions{})
+	assert.Nil(t, err)
+
+	idx := slices.IndexFunc(voicings, func(voicing Voicing) bool {
+		return voicing.String() == "133211"
+	})
+	assert.GreaterOrEqual(t, idx, 0)
+	assert.True(t, voicings[idx].Barre)
+	assert.Equal(t, "134211", voicings[idx].FingerChart())
+
+	// slash chords have their bass note at the bottom
+	chord, _ = music.ParseChord("D/F#")
+	voicings, err = fretboard.ChordVoicings(chord, VoicingOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "200232", voicings[0].String())
+}
+
+func TestFretboard_ChordVoicings_Ranking(t *testing.T) {
+	fretboard := NewFretboard(16, StandardTuning())
+
+	chord, _ := music.ParseChord("C")
+	voicings, err := fretboard.ChordVoicings(chord, VoicingOptions{})
+	assert.Nil(t, err)
+
+	indexOf := func(chart string) int {
+		return slices.IndexFunc(voicings, func(voicing Voicing) bool {
+			return voicing.String() == chart
+		})
+	}
+
+	openChord, barreChord, hybrid := indexOf("x32010"), indexOf("x35553"), indexOf("8-10-10-0-8-0")
+	assert.GreaterOrEqual(t, openChord, 0)
+	assert.GreaterOrEqual(t, barreChord, 0)
+	assert.GreaterOrEqual(t, hybrid, 0)
+
+	// open strings under a shape high up the neck stretch the hand as far as the nut
+	assert.Less(t, openChord, hybrid)
+	assert.Less(t, barreChord, hybrid)
+}
+
+func TestFretboard_Voicings_Constraints(t *testing.T) {
+	fretboard := NewFretboard(16, StandardTuning())
+
+	chord, _ := music.ParseChord("G7")
+	voicings, err := fretboard.Voicings(chord.Notes(), chord.Bass, VoicingOptions{
+		MaxFretSpan:         3,
+		MaxFingers:          3,
+		NoMutedInnerStrings: true,
+	})
+	assert.Nil(t, err)
+
+	for _, voicing := range voicings {
+		lowestFretted, highestFretted := 16, 0
+		for strIdx, fretNumber := range voicing.Frets {
+			if voicing.Fingers[strIdx] != 0 {
+				lowestFretted = min(lowestFretted, fretNumber)
+				highestFretted = max(highestFretted, fretNumber)
+			}
+		}
+		assert.Less(t, highestFretted-lowestFretted, 3, voicing.String())
+		assert.LessOrEqual(t, slices.Max(voicing.Fingers), 3, voicing.String())
+
+		// the strings played are next to each other
+		positions := voicing.Positions()
+		assert.Equal(t, len(positions)-1, positions[0].StringNumber-positions[len(positions)-1].StringNumber, voicing.String())
+	}
+
+	// F# and C are never on the same fret, so one finger can't play both
+	chord, _ = music.ParseChord("F#m7b5")
+	_, err = fretboard.ChordVoicings(chord, VoicingOptions{MaxFretSpan: 1, MaxFingers: 1})
+	assert.Error(t, err)
+
+	_, err = fretboard.Voicings([]*music.Note{}, chord.Bass, VoicingOptions{})
+	assert.Error(t, err)
+
+	// six notes don't fit on four strings
+	profile, _ := FindProfile("ukulele")
+	ukulele := profile.NewFretboard()
+	chord, _ = music.ParseChord("C13")
+	_, err = ukulele.ChordVoicings(chord, VoicingOptions{})
+	assert.Error(t, err)
+
+	chord, _ = music.ParseChord("Am7")
+	voicings, err = ukulele.ChordVoicings(chord, VoicingOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "0000", voicings[0].String())
+}
+
+func TestFretboard_Voicings_Families(t *testing.T) {
+	fretboard := NewFretboard(16, StandardTuning())
+	chord, _ := music.ParseChord("Cmaj7")
+
+	testCases := []struct {
+		family   VoicingFamily
+		expected string
+	}{
+		// C3 G3 B3 E4, the close voicing G3 B3 C4 E4 with C4 dropped an octave
+		{Drop2Voicing, "x3545x"},
+		// C3 B3 E4 G4, the close voicing B3 C4 E4 G4 with C4 dropped an octave
+		{Drop3Voicing, "8x998x"},
+		// C3 E3 G3 B3
+		{CloseVoicing, "x3200x"},
+	}
+
+	for _, testCase := range testCases {
+		voicings, err := fretboard.ChordVoicings(chord, VoicingOptions{Family: testCase.family})
+		assert.Nil(t, err)
+
+		charts := make([]string, 0)
+		for _, voicing := range voicings {
+			charts = append(charts, voicing.String())
+		}
+		assert.Contains(t, charts, testCase.expected, testCase.family.String())
+		assert.NotContains(t, charts, "x32000", testCase.family.String())
+	}
+}
+
+func TestFretboard_Voicings_Capo(t *testing.T) {
+	fretboard := NewFretboard(16, StandardTuning())
+	assert.Nil(t, fretboard.SetCapo(NewCapo(2)))
+
+	chord, _ := music.ParseChord("D")
+	voicings, err := fretboard.ChordVoicings(chord, VoicingOptions{RootInBass: true})
+	assert.Nil(t, err)
+
+	// the C shape with the capo at fret 2
+	assert.Equal(t, "x54232", voicings[0].String())
+	assert.Equal(t, "x32010", voicings[0].FingerChart())
+
+	for _, voicing := range voicings {
+		for _, position := range voicing.Positions() {
+			assert.GreaterOrEqual(t, position.FretNumber, 2, voicing.String())
+		}
+	}
+}
+
+func TestVoicing_String(t *testing.T) {
+	voicing := Voicing{
+		Frets:   []int{10, 12, 12, 12, 10, MutedString},
+		Fingers: []int{1, 4, 3, 2, 1, 0},
+		Barre:   true,
+	}
+
+	assert.Equal(t, "x-10-12-12-12-10", voicing.String())
+	assert.Equal(t, "x12341", voicing.FingerChart())
+}
+
+func TestParseVoicingFamily(t *testing.T) {
+	family, err := ParseVoicingFamily("Drop-2")
+	assert.Nil(t, err)
+	assert.Equal(t, Drop2Voicing, family)
+
+	family, err = ParseVoicingFamily("drop3")
+	assert.Nil(t, err)
+	assert.Equal(t, Drop3Voicing, family)
+
+	_, err = ParseVoicingFamily("drop 4")
+	assert.Error(t, err)
+}
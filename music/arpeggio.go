@@ -0,0 +1,119 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+)
+
+type ArpeggioDirection int
+
+const (
+	ArpeggioUp ArpeggioDirection = iota
+	ArpeggioDown
+	// ArpeggioUpDown goes up and comes back down without repeating the top note
+	ArpeggioUpDown
+)
+
+type ArpeggioOptions struct {
+	// StartOctave is the octave of the root, in scientific pitch notation (e.g. 3 for C3)
+	StartOctave int
+	// Octaves is how many octaves the arpeggio spans, defaulting to 1
+	Octaves   int
+	Direction ArpeggioDirection
+	// Inversion picks the chord tone the arpeggio starts on (0 for the root, 1 for the third...)
+	Inversion int
+	// Extensions keeps 9ths, 11ths and 13ths, folded into the octave next to the other tones
+	Extensions bool
+}
+
+// Arpeggio plays the tones of the chord one after the other, finishing on the note it started on
+// once the last octave is reached.
+func (c *Chord) Arpeggio(options ArpeggioOptions) ([]*Pitch, error) {
+	octaves := options.Octaves
+	if octaves == 0 {
+		octaves = 1
+	}
+	if octaves < 0 {
+		return nil, fmt.Errorf("arpeggio needs at least one octave, got '%d'", octaves)
+	}
+
+	tones := make([]ChordTone, 0, len(c.Tones))
+	for _, tone := range c.Tones {
+		if tone.Interval.IsCompound() && !options.Extensions {
+			continue
+		}
+		tones = append(tones, tone)
+	}
+
+	slices.SortStableFunc(tones, func(a, b ChordTone) int {
+		return mod(a.Interval.Semitones(), PitchClassCount) - mod(b.Interval.Semitones(), PitchClassCount)
+	})
+
+	if options.Inversion < 0 || options.Inversion >= len(tones) {
+		return nil, fmt.Errorf("inversion '%d' doesn't exist for a chord with %d tones", options.Inversion, len(tones))
+	}
+
+	rootMIDI := NewPitch(c.Root, options.StartOctave).MIDI()
+	pitches := make([]*Pitch, 0, len(tones)*octaves+1)
+
+	for i := 0; i <= len(tones)*octaves; i++ {
+		toneIndex := options.Inversion + i
+		tone := tones[toneIndex%len(tones)]
+		octave := toneIndex / len(tones)
+
+		midiNumber := rootMIDI + mod(tone.Interval.Semitones(), PitchClassCount) + octave*PitchClassCount
+		pitch, err := spellPitch(tone.Note, midiNumber)
+		if err != nil {
+			return nil, err
+		}
+		pitches = append(pitches, pitch)
+	}
+
+	switch options.Direction {
+	case ArpeggioDown:
+		slices.Reverse(pitches)
+	case ArpeggioUpDown:
+		descending := slices.Clone(pitches[:len(pitches)-1])
+		slices.Reverse(descending)
+		pitches = append(pitches, descending...)
+	}
+
+	return pitches, nil
+}
+
+// DegreeChord builds a chord out of scale degrees, such as 1 3 5 7 for the seventh chord on the
+// root of the scale or 1 3 5 9 for an add9 chord. Degrees above the octave are kept as
+// extensions.
+func (s *Scale) DegreeChord(degrees []int) (*Chord, error) {
+	intervals := make([]Interval, 0, len(degrees))
+	for _, degree := range degrees {
+		note, err := s.Degree(degree)
+		if err != nil {
+			return nil, err
+		}
+
+		interval, err := s.Root.IntervalTo(note)
+		if err != nil {
+			return nil, err
+		}
+
+		// IntervalTo returns simple intervals, so an octave is added to degrees above the octave
+		if degree > len(s.Notes) && interval.Number+lettersPerOctave <= MaxIntervalNumber {
+			interval.Number += lettersPerOctave
+		}
+		intervals = append(intervals, interval)
+	}
+
+	return NewChord(&s.Root, intervals)
+}
+
+// spellPitch returns the pitch with a MIDI note number spelled as the given note. The octave is
+// worked out from the note's letter, so B#3 and C4 both have MIDI number 60.
+func spellPitch(note *Note, midiNumber int) (*Pitch, error) {
+	semitones, err := semitonesFromC(note.Name, note.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPitch(note, (midiNumber-semitones)/PitchClassCount-1), nil
+}
@@ -0,0 +1,75 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pitchNames(pitches []*Pitch) []string {
+	names := make([]string, len(pitches))
+	for i, pitch := range pitches {
+		names[i] = pitch.String()
+	}
+
+	return names
+}
+
+func TestArpeggio_Chord(t *testing.T) {
+	testCases := []struct {
+		symbol   string
+		options  ArpeggioOptions
+		expected []string
+	}{
+		{"C", ArpeggioOptions{StartOctave: 3}, []string{"C3", "E3", "G3", "C4"}},
+		{"C", ArpeggioOptions{StartOctave: 3, Octaves: 2}, []string{"C3", "E3", "G3", "C4", "E4", "G4", "C5"}},
+		{"Cmaj7", ArpeggioOptions{StartOctave: 3, Direction: ArpeggioDown}, []string{"C4", "B3", "G3", "E3", "C3"}},
+		{"C", ArpeggioOptions{StartOctave: 3, Direction: ArpeggioUpDown}, []string{"C3", "E3", "G3", "C4", "G3", "E3", "C3"}},
+		// inversions start on another chord tone and finish on it
+		{"Am", ArpeggioOptions{StartOctave: 3, Inversion: 1}, []string{"C4", "E4", "A4", "C5"}},
+		{"G7", ArpeggioOptions{StartOctave: 2, Inversion: 3}, []string{"F3", "G3", "B3", "D4", "F4"}},
+		// extensions are left out unless asked for
+		{"C9", ArpeggioOptions{StartOctave: 3}, []string{"C3", "E3", "G3", "Bb3", "C4"}},
+		{"C9", ArpeggioOptions{StartOctave: 3, Extensions: true}, []string{"C3", "D3", "E3", "G3", "Bb3", "C4"}},
+		// spelling is kept across octave boundaries
+		{"C#", ArpeggioOptions{StartOctave: 3}, []string{"C#3", "E#3", "G#3", "C#4"}},
+		{"Ab", ArpeggioOptions{StartOctave: 3}, []string{"Ab3", "C4", "Eb4", "Ab4"}},
+	}
+
+	for _, testCase := range testCases {
+		chord, _ := ParseChord(testCase.symbol)
+
+		pitches, err := chord.Arpeggio(testCase.options)
+		if assert.Nil(t, err, testCase.symbol) {
+			assert.Equal(t, testCase.expected, pitchNames(pitches), testCase.symbol)
+		}
+	}
+
+	chord, _ := ParseChord("C")
+	_, err := chord.Arpeggio(ArpeggioOptions{Inversion: 3})
+	assert.Error(t, err)
+
+	_, err = chord.Arpeggio(ArpeggioOptions{Octaves: -1})
+	assert.Error(t, err)
+}
+
+func TestArpeggio_DegreeChord(t *testing.T) {
+	c, _ := ParseNote("C")
+	scale, _ := NewScale(c, MajorScale)
+
+	chord, err := scale.DegreeChord([]int{1, 3, 5, 7})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C", "E", "G", "B"}, noteNames(chord.Notes()))
+	assert.Equal(t, []string{"R", "3", "5", "7"}, chord.Degrees())
+
+	chord, err = scale.DegreeChord([]int{1, 3, 5, 9})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"R", "3", "5", "9"}, chord.Degrees())
+
+	pitches, err := chord.Arpeggio(ArpeggioOptions{StartOctave: 4, Extensions: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C4", "D4", "E4", "G4", "C5"}, pitchNames(pitches))
+
+	_, err = scale.DegreeChord([]int{0})
+	assert.Error(t, err)
+}
@@ -0,0 +1,320 @@
+package music
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type ChordTone struct {
+	Note     *Note
+	Interval Interval
+	// Degree is the label of the tone relative to the root (e.g. R, b3, 5, b7, #11)
+	Degree string
+}
+
+type Chord struct {
+	Symbol string
+	Root   *Note
+	// Bass is the lowest note of the chord, which is the root unless it's a slash chord
+	Bass  *Note
+	Tones []ChordTone
+}
+
+// chordSpelling keeps track of the intervals of a chord while its symbol is being parsed.
+// map[degree]: interval, where degree is the simple interval number (or 9, 11 and 13 for extensions)
+type chordSpelling map[int]Interval
+
+func NewChord(root *Note, intervals []Interval) (*Chord, error) {
+	if len(intervals) == 0 {
+		return nil, fmt.Errorf("chord needs at least one interval")
+	}
+
+	tones := make([]ChordTone, 0, len(intervals))
+	for _, interval := range intervals {
+		note, err := root.Add(interval)
+		if err != nil {
+			return nil, err
+		}
+
+		tones = append(tones, ChordTone{
+			Note:     note,
+			Interval: interval,
+			Degree:   degreeLabel(interval),
+		})
+	}
+
+	sort.SliceStable(tones, func(i, j int) bool {
+		return tones[i].Interval.Semitones() < tones[j].Interval.Semitones()
+	})
+
+	return &Chord{
+		Root:  root,
+		Bass:  root,
+		Tones: tones,
+	}, nil
+}
+
+// ParseChord parses chord symbols such as "C", "Am", "Cmaj7", "F#m7b5", "Bb13#11", "Dsus4",
+// "G7/B" or "Eadd9". Chord symbols always use English note names, whatever the default naming
+// system is, as otherwise suffixes like "sus" would be ambiguous (e.g. German "Es").
+func ParseChord(symbol string) (*Chord, error) {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return nil, fmt.Errorf("chord symbol is empty")
+	}
+
+	// 6/9 chords use a slash that isn't a bass note
+	body := strings.Replace(symbol, "6/9", "69", 1)
+
+	bassName := ""
+	if slashIndex := strings.LastIndex(body, "/"); slashIndex >= 0 {
+		bassName = body[slashIndex+1:]
+		body = body[:slashIndex]
+	}
+
+	root, suffix, err := parseChordRoot(body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chord '%s': %v", symbol, err)
+	}
+
+	spelling, err := parseChordSuffix(suffix)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chord '%s': %v", symbol, err)
+	}
+
+	chord, err := NewChord(root, spelling.intervals())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chord '%s': %v", symbol, err)
+	}
+	chord.Symbol = symbol
+
+	if bassName != "" {
+		bass, remaining, err := parseChordRoot(bassName)
+		if err != nil || remaining != "" {
+			return nil, fmt.Errorf("error parsing bass note '%s' of chord '%s'", bassName, symbol)
+		}
+		chord.Bass = bass
+	}
+
+	return chord, nil
+}
+
+// parseChordRoot splits a chord symbol into its root note and the remaining suffix.
+func parseChordRoot(symbol string) (*Note, string, error) {
+	if symbol == "" {
+		return nil, "", fmt.Errorf("missing root note")
+	}
+
+	note, consumed, err := scanNote(symbol, EnglishNaming)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return note, symbol[consumed:], nil
+}
+
+func parseChordSuffix(suffix string) (chordSpelling, error) {
+	spelling := chordSpelling{1: PerfectUnison, 3: MajorThird, 5: PerfectFifth}
+	seventh := MinorSeventh
+	remaining := suffix
+
+	// chord quality
+	switch {
+	case hasAnyPrefix(remaining, "mMaj", "mmaj", "minmaj", "m(maj", "mM", "-Maj", "-maj"):
+		spelling[3] = MinorThird
+		seventh = MajorSeventh
+		remaining = trimAnyPrefix(remaining, "mMaj", "mmaj", "minmaj", "m(maj", "mM", "-Maj", "-maj")
+		if !startsWithDigit(remaining) {
+			remaining = "7" + remaining
+		}
+	case hasAnyPrefix(remaining, "maj", "Maj", "M", "Δ"):
+		remaining = trimAnyPrefix(remaining, "maj", "Maj", "M", "Δ")
+		seventh = MajorSeventh
+	case hasAnyPrefix(remaining, "dim", "°", "o"):
+		remaining = trimAnyPrefix(remaining, "dim", "°", "o")
+		spelling[3] = MinorThird
+		spelling[5] = DiminishedFifth
+		seventh = DiminishedSeventh
+	case hasAnyPrefix(remaining, "ø"):
+		remaining = trimAnyPrefix(remaining, "ø")
+		spelling[3] = MinorThird
+		spelling[5] = DiminishedFifth
+		if !startsWithDigit(remaining) {
+			remaining = "7" + remaining
+		}
+	case hasAnyPrefix(remaining, "min", "m", "-"):
+		remaining = trimAnyPrefix(remaining, "min", "m", "-")
+		spelling[3] = MinorThird
+	case hasAnyPrefix(remaining, "aug", "+"):
+		remaining = trimAnyPrefix(remaining, "aug", "+")
+		spelling[5] = AugmentedFifth
+	case remaining == "5":
+		delete(spelling, 3)
+		return spelling, nil
+	}
+
+	// extensions
+	extensions := []struct {
+		token     string
+		intervals []Interval
+	}{
+		{"69", []Interval{MajorSixth, MajorNinth}},
+		{"13", []Interval{seventh, MajorNinth, MajorThirteenth}},
+		{"11", []Interval{seventh, MajorNinth, PerfectEleventh}},
+		{"9", []Interval{seventh, MajorNinth}},
+		{"7", []Interval{seventh}},
+		{"6", []Interval{MajorSixth}},
+	}
+
+	for _, extension := range extensions {
+		if strings.HasPrefix(remaining, extension.token) {
+			for _, interval := range extension.intervals {
+				spelling.set(interval)
+			}
+			remaining = strings.TrimPrefix(remaining, extension.token)
+
+			// minor 13th chords keep the 11th as it doesn't clash with the minor third
+			if extension.token == "13" && spelling[3] == MinorThird {
+				spelling.set(PerfectEleventh)
+			}
+			break
+		}
+	}
+
+	// alterations, suspensions and added tones
+	modifiers := []struct {
+		token string
+		apply func(spelling chordSpelling)
+	}{
+		{"sus2", func(s chordSpelling) { delete(s, 3); s[2] = MajorSecond }},
+		{"sus4", func(s chordSpelling) { delete(s, 3); s[4] = PerfectFourth }},
+		{"sus", func(s chordSpelling) { delete(s, 3); s[4] = PerfectFourth }},
+		{"add13", func(s chordSpelling) { s.set(MajorThirteenth) }},
+		{"add11", func(s chordSpelling) { s.set(PerfectEleventh) }},
+		{"add9", func(s chordSpelling) { s.set(MajorNinth) }},
+		{"add6", func(s chordSpelling) { s.set(MajorSixth) }},
+		{"add4", func(s chordSpelling) { s.set(PerfectFourth) }},
+		{"add2", func(s chordSpelling) { s.set(MajorSecond) }},
+		{"b13", func(s chordSpelling) { s.set(MinorThirteenth) }},
+		{"#11", func(s chordSpelling) { s.set(AugmentedEleventh) }},
+		{"b9", func(s chordSpelling) { s.set(MinorNinth) }},
+		{"#9", func(s chordSpelling) { s.set(AugmentedNinth) }},
+		{"b5", func(s chordSpelling) { s.set(DiminishedFifth) }},
+		{"#5", func(s chordSpelling) { s.set(AugmentedFifth) }},
+		{"no3", func(s chordSpelling) { delete(s, 3) }},
+		{"no5", func(s chordSpelling) { delete(s, 5) }},
+	}
+
+	for remaining != "" {
+		remaining = strings.TrimLeft(remaining, "(),")
+		if remaining == "" {
+			break
+		}
+
+		matched := false
+		for _, modifier := range modifiers {
+			if strings.HasPrefix(remaining, modifier.token) {
+				modifier.apply(spelling)
+				remaining = strings.TrimPrefix(remaining, modifier.token)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("unrecognised chord suffix '%s'", remaining)
+		}
+	}
+
+	return spelling, nil
+}
+
+func (s chordSpelling) set(interval Interval) {
+	s[interval.Number] = interval
+}
+
+func (s chordSpelling) intervals() []Interval {
+	intervals := make([]Interval, 0, len(s))
+	for _, interval := range s {
+		intervals = append(intervals, interval)
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Semitones() < intervals[j].Semitones()
+	})
+
+	return intervals
+}
+
+func (c *Chord) Notes() []*Note {
+	notes := make([]*Note, len(c.Tones))
+	for i, tone := range c.Tones {
+		notes[i] = tone.Note
+	}
+
+	return notes
+}
+
+func (c *Chord) Degrees() []string {
+	degrees := make([]string, len(c.Tones))
+	for i, tone := range c.Tones {
+		degrees[i] = tone.Degree
+	}
+
+	return degrees
+}
+
+func (c *Chord) IsSlashChord() bool {
+	return !c.Bass.EnharmonicEquals(c.Root)
+}
+
+func (c *Chord) String() string {
+	return c.Symbol
+}
+
+// degreeLabel names an interval the way chord tones are usually labelled, relative to the
+// major scale of the root (e.g. m3 is b3, d7 is bb7 and A11 is #11).
+func degreeLabel(interval Interval) string {
+	if interval == PerfectUnison {
+		return "R"
+	}
+
+	switch interval.Quality {
+	case Minor:
+		return fmt.Sprintf("b%d", interval.Number)
+	case Augmented:
+		return fmt.Sprintf("#%d", interval.Number)
+	case Diminished:
+		if interval.isPerfectType() {
+			return fmt.Sprintf("b%d", interval.Number)
+		}
+		return fmt.Sprintf("bb%d", interval.Number)
+	default:
+		return fmt.Sprintf("%d", interval.Number)
+	}
+}
+
+func hasAnyPrefix(s string, prefixes ...string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func trimAnyPrefix(s string, prefixes ...string) string {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimPrefix(s, prefix)
+		}
+	}
+
+	return s
+}
+
+func startsWithDigit(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
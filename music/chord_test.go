@@ -0,0 +1,103 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChord_ParseChord(t *testing.T) {
+	testCases := []struct {
+		symbol  string
+		notes   []string
+		degrees []string
+	}{
+		{"C", []string{"C", "E", "G"}, []string{"R", "3", "5"}},
+		{"Am", []string{"A", "C", "E"}, []string{"R", "b3", "5"}},
+		{"Cmaj7", []string{"C", "E", "G", "B"}, []string{"R", "3", "5", "7"}},
+		{"CΔ7", []string{"C", "E", "G", "B"}, []string{"R", "3", "5", "7"}},
+		{"G7", []string{"G", "B", "D", "F"}, []string{"R", "3", "5", "b7"}},
+		{"Dm7", []string{"D", "F", "A", "C"}, []string{"R", "b3", "5", "b7"}},
+		{"F#m7b5", []string{"F#", "A", "C", "E"}, []string{"R", "b3", "b5", "b7"}},
+		{"Bø7", []string{"B", "D", "F", "A"}, []string{"R", "b3", "b5", "b7"}},
+		{"Bdim7", []string{"B", "D", "F", "Ab"}, []string{"R", "b3", "b5", "bb7"}},
+		{"Cdim", []string{"C", "Eb", "Gb"}, []string{"R", "b3", "b5"}},
+		{"Caug", []string{"C", "E", "G#"}, []string{"R", "3", "#5"}},
+		{"Dsus4", []string{"D", "G", "A"}, []string{"R", "4", "5"}},
+		{"Dsus2", []string{"D", "E", "A"}, []string{"R", "2", "5"}},
+		{"G7sus4", []string{"G", "C", "D", "F"}, []string{"R", "4", "5", "b7"}},
+		{"Eadd9", []string{"E", "G#", "B", "F#"}, []string{"R", "3", "5", "9"}},
+		{"C6", []string{"C", "E", "G", "A"}, []string{"R", "3", "5", "6"}},
+		{"Am6", []string{"A", "C", "E", "F#"}, []string{"R", "b3", "5", "6"}},
+		{"C6/9", []string{"C", "E", "G", "A", "D"}, []string{"R", "3", "5", "6", "9"}},
+		{"E5", []string{"E", "B"}, []string{"R", "5"}},
+		{"CmMaj7", []string{"C", "Eb", "G", "B"}, []string{"R", "b3", "5", "7"}},
+		{"Cm(maj7)", []string{"C", "Eb", "G", "B"}, []string{"R", "b3", "5", "7"}},
+		{"E7#9", []string{"E", "G#", "B", "D", "Fx"}, []string{"R", "3", "5", "b7", "#9"}},
+		{"A7b9", []string{"A", "C#", "E", "G", "Bb"}, []string{"R", "3", "5", "b7", "b9"}},
+		{"Dm9", []string{"D", "F", "A", "C", "E"}, []string{"R", "b3", "5", "b7", "9"}},
+		{"Cmaj9", []string{"C", "E", "G", "B", "D"}, []string{"R", "3", "5", "7", "9"}},
+		{"Am11", []string{"A", "C", "E", "G", "B", "D"}, []string{"R", "b3", "5", "b7", "9", "11"}},
+		{"Bb13#11", []string{"Bb", "D", "F", "Ab", "C", "E", "G"}, []string{"R", "3", "5", "b7", "9", "#11", "13"}},
+		{"Fm13", []string{"F", "Ab", "C", "Eb", "G", "Bb", "D"}, []string{"R", "b3", "5", "b7", "9", "11", "13"}},
+		{"C7b13", []string{"C", "E", "G", "Bb", "Ab"}, []string{"R", "3", "5", "b7", "b13"}},
+	}
+
+	for _, testCase := range testCases {
+		chord, err := ParseChord(testCase.symbol)
+		if !assert.Nil(t, err, testCase.symbol) {
+			continue
+		}
+
+		noteNames := make([]string, 0)
+		for _, note := range chord.Notes() {
+			noteNames = append(noteNames, note.String())
+		}
+
+		assert.Equal(t, testCase.notes, noteNames, testCase.symbol)
+		assert.Equal(t, testCase.degrees, chord.Degrees(), testCase.symbol)
+		assert.Equal(t, testCase.symbol, chord.String())
+		assert.False(t, chord.IsSlashChord())
+	}
+}
+
+func TestChord_ParseChord_SlashChords(t *testing.T) {
+	chord, err := ParseChord("G7/B")
+	assert.Nil(t, err)
+	assert.Equal(t, "G", chord.Root.String())
+	assert.Equal(t, "B", chord.Bass.String())
+	assert.True(t, chord.IsSlashChord())
+	assert.Len(t, chord.Tones, 4)
+
+	// bass note outside of the chord
+	chord, err = ParseChord("C/Bb")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bb", chord.Bass.String())
+	assert.Len(t, chord.Tones, 3)
+
+	_, err = ParseChord("C/H")
+	assert.Error(t, err)
+
+	_, err = ParseChord("C/Em")
+	assert.Error(t, err)
+}
+
+func TestChord_ParseChord_InvalidSymbols(t *testing.T) {
+	for _, invalid := range []string{"", "   ", "H7", "Cfoo", "C7x", "m7"} {
+		_, err := ParseChord(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestChord_NewChord(t *testing.T) {
+	root := mustParseTestNote(t, "Eb")
+
+	chord, err := NewChord(root, []Interval{PerfectFifth, PerfectUnison, MajorThird})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"R", "3", "5"}, chord.Degrees())
+	assert.Equal(t, "G", chord.Tones[1].Note.String())
+	assert.Equal(t, root, chord.Bass)
+
+	_, err = NewChord(root, []Interval{})
+	assert.Error(t, err)
+}
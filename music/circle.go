@@ -0,0 +1,159 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+)
+
+// number of keys around the circle of fifths before the spelling wraps around enharmonically
+const circleOfFifthsKeys = 12
+
+// accidental added to a letter for every lap around the line of fifths
+var fifthsLapAccidentals = map[int]Accidental{
+	-2: DoubleFlat,
+	-1: Flat,
+	0:  Natural,
+	1:  Sharp,
+	2:  DoubleSharp,
+}
+
+// KeyFromFifths returns the key whose signature has the given number of sharps (positive) or
+// flats (negative).
+func KeyFromFifths(fifths int, mode KeyMode) (*Key, error) {
+	if fifths < -maxKeySignatureAccidentals || fifths > maxKeySignatureAccidentals {
+		return nil, fmt.Errorf("key signatures have at most %d accidentals, got %d", maxKeySignatureAccidentals, abs(fifths))
+	}
+
+	tonicFifths := fifths
+	if mode == MinorKey {
+		tonicFifths -= minorKeyFifthsOffset
+	}
+
+	// sharpsOrder starts on F, which is one fifth below C
+	position := tonicFifths - naturalNoteFifths[sharpsOrder[0]]
+	tonic, err := NewNote(sharpsOrder[mod(position, lettersPerOctave)], fifthsLapAccidentals[floorDiv(position, lettersPerOctave)])
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKey(tonic, mode)
+}
+
+// CircleOfFifths returns the 12 keys of a mode clockwise from C major (or A minor), switching to
+// flat keys after 6 sharps.
+func CircleOfFifths(mode KeyMode) []*Key {
+	keys := make([]*Key, 0, circleOfFifthsKeys)
+	for step := range circleOfFifthsKeys {
+		fifths := step
+		if fifths > circleOfFifthsKeys/2 {
+			fifths -= circleOfFifthsKeys
+		}
+
+		// every signature between -7 and 7 exists, so this can't fail
+		key, _ := KeyFromFifths(fifths, mode)
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Walk moves the given number of steps clockwise (sharpwards) around the circle of fifths, or
+// anticlockwise when steps is negative. Keys that would need more than 7 accidentals are
+// replaced by their enharmonic equivalent (e.g. a fifth above C# major is Ab major).
+func (k *Key) Walk(steps int) *Key {
+	fifths := k.Fifths() + steps
+	for fifths > maxKeySignatureAccidentals {
+		fifths -= circleOfFifthsKeys
+	}
+	for fifths < -maxKeySignatureAccidentals {
+		fifths += circleOfFifthsKeys
+	}
+
+	key, _ := KeyFromFifths(fifths, k.Mode)
+	return key
+}
+
+// Dominant returns the key a fifth above (one step clockwise).
+func (k *Key) Dominant() *Key {
+	return k.Walk(1)
+}
+
+// Subdominant returns the key a fifth below (one step anticlockwise).
+func (k *Key) Subdominant() *Key {
+	return k.Walk(-1)
+}
+
+// Relative returns the key sharing the same key signature in the other mode (e.g. A minor for
+// C major).
+func (k *Key) Relative() *Key {
+	mode := MinorKey
+	if k.Mode == MinorKey {
+		mode = MajorKey
+	}
+
+	key, _ := KeyFromFifths(k.Fifths(), mode)
+	return key
+}
+
+// Parallel returns the key with the same tonic in the other mode (e.g. C minor for C major). It
+// fails when that key would need more than 7 accidentals (e.g. Db minor).
+func (k *Key) Parallel() (*Key, error) {
+	mode := MinorKey
+	if k.Mode == MinorKey {
+		mode = MajorKey
+	}
+
+	return NewKey(&k.Tonic, mode)
+}
+
+// CloselyRelatedKeys returns the keys whose signature differs by at most one accidental: the
+// relative key, the dominant and subdominant keys and their relatives.
+func (k *Key) CloselyRelatedKeys() []*Key {
+	dominant := k.Dominant()
+	subdominant := k.Subdominant()
+
+	return []*Key{
+		k.Relative(),
+		dominant,
+		dominant.Relative(),
+		subdominant,
+		subdominant.Relative(),
+	}
+}
+
+// AccidentalDistance returns how many accidentals have to change to go from one key signature to
+// the other (e.g. 3 between D major and F major).
+func (k *Key) AccidentalDistance(anotherKey *Key) int {
+	return abs(k.Fifths() - anotherKey.Fifths())
+}
+
+// CircleDistance returns the number of steps between both keys around the circle of fifths,
+// treating enharmonic keys (e.g. C# and Db major) as the same position.
+func (k *Key) CircleDistance(anotherKey *Key) int {
+	distance := mod(k.Fifths()-anotherKey.Fifths(), circleOfFifthsKeys)
+	return min(distance, circleOfFifthsKeys-distance)
+}
+
+// SortKeysByDifficulty orders keys by how many accidentals their signature has, keeping sharp
+// keys before flat keys with the same number of accidentals and major keys before minor keys.
+func SortKeysByDifficulty(keys []*Key) []*Key {
+	sortedKeys := slices.Clone(keys)
+	slices.SortStableFunc(sortedKeys, func(a, b *Key) int {
+		if difference := abs(a.Fifths()) - abs(b.Fifths()); difference != 0 {
+			return difference
+		}
+
+		if difference := b.Fifths() - a.Fifths(); difference != 0 {
+			return difference
+		}
+
+		return int(a.Mode) - int(b.Mode)
+	})
+
+	return sortedKeys
+}
+
+// floorDiv divides rounding towards negative infinity, so it pairs with mod.
+func floorDiv(a int, b int) int {
+	return (a - mod(a, b)) / b
+}
@@ -0,0 +1,139 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func keyNames(keys []*Key) []string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.String()
+	}
+
+	return names
+}
+
+func TestCircle_KeyFromFifths(t *testing.T) {
+	testCases := []struct {
+		fifths   int
+		mode     KeyMode
+		expected string
+	}{
+		{0, MajorKey, "C major"},
+		{0, MinorKey, "A minor"},
+		{1, MajorKey, "G major"},
+		{-1, MajorKey, "F major"},
+		{-2, MajorKey, "Bb major"},
+		{6, MajorKey, "F# major"},
+		{7, MajorKey, "C# major"},
+		{-7, MajorKey, "Cb major"},
+		{7, MinorKey, "A# minor"},
+		{-7, MinorKey, "Ab minor"},
+		{-3, MinorKey, "C minor"},
+	}
+
+	for _, testCase := range testCases {
+		key, err := KeyFromFifths(testCase.fifths, testCase.mode)
+		if assert.Nil(t, err) {
+			assert.Equal(t, testCase.expected, key.String())
+			assert.Equal(t, testCase.fifths, key.Fifths())
+		}
+	}
+
+	_, err := KeyFromFifths(8, MajorKey)
+	assert.Error(t, err)
+}
+
+func TestCircle_CircleOfFifths(t *testing.T) {
+	assert.Equal(t, []string{
+		"C major", "G major", "D major", "A major", "E major", "B major",
+		"F# major", "Db major", "Ab major", "Eb major", "Bb major", "F major",
+	}, keyNames(CircleOfFifths(MajorKey)))
+
+	assert.Equal(t, []string{
+		"A minor", "E minor", "B minor", "F# minor", "C# minor", "G# minor",
+		"D# minor", "Bb minor", "F minor", "C minor", "G minor", "D minor",
+	}, keyNames(CircleOfFifths(MinorKey)))
+}
+
+func TestCircle_Walk(t *testing.T) {
+	cMajor, _ := ParseKey("C")
+
+	assert.Equal(t, "C major", cMajor.Walk(0).String())
+	assert.Equal(t, "E major", cMajor.Walk(4).String())
+	assert.Equal(t, "Eb major", cMajor.Walk(-3).String())
+	assert.Equal(t, "C major", cMajor.Walk(12).String())
+	assert.Equal(t, "G major", cMajor.Dominant().String())
+	assert.Equal(t, "F major", cMajor.Subdominant().String())
+
+	// wraps enharmonically instead of using theoretical keys
+	cSharpMajor, _ := ParseKey("C#")
+	assert.Equal(t, "Ab major", cSharpMajor.Dominant().String())
+	cFlatMajor, _ := ParseKey("Cb")
+	assert.Equal(t, "E major", cFlatMajor.Subdominant().String())
+
+	dMinor, _ := ParseKey("Dm")
+	assert.Equal(t, "A minor", dMinor.Dominant().String())
+}
+
+func TestCircle_RelativeAndParallel(t *testing.T) {
+	cMajor, _ := ParseKey("C")
+	assert.Equal(t, "A minor", cMajor.Relative().String())
+
+	fSharpMinor, _ := ParseKey("F#m")
+	assert.Equal(t, "A major", fSharpMinor.Relative().String())
+
+	parallel, err := cMajor.Parallel()
+	assert.Nil(t, err)
+	assert.Equal(t, "C minor", parallel.String())
+
+	parallel, err = fSharpMinor.Parallel()
+	assert.Nil(t, err)
+	assert.Equal(t, "F# major", parallel.String())
+
+	// Db minor would need 8 flats
+	dFlatMajor, _ := ParseKey("Db")
+	_, err = dFlatMajor.Parallel()
+	assert.Error(t, err)
+}
+
+func TestCircle_CloselyRelatedKeys(t *testing.T) {
+	cMajor, _ := ParseKey("C")
+	assert.Equal(t, []string{"A minor", "G major", "E minor", "F major", "D minor"}, keyNames(cMajor.CloselyRelatedKeys()))
+
+	eMinor, _ := ParseKey("Em")
+	assert.Equal(t, []string{"G major", "B minor", "D major", "A minor", "C major"}, keyNames(eMinor.CloselyRelatedKeys()))
+}
+
+func TestCircle_Distance(t *testing.T) {
+	dMajor, _ := ParseKey("D")
+	fMajor, _ := ParseKey("F")
+	bMinor, _ := ParseKey("Bm")
+	cSharpMajor, _ := ParseKey("C#")
+	dFlatMajor, _ := ParseKey("Db")
+
+	assert.Equal(t, 3, dMajor.AccidentalDistance(fMajor))
+	assert.Equal(t, 3, dMajor.CircleDistance(fMajor))
+	assert.Equal(t, 0, dMajor.AccidentalDistance(bMinor))
+
+	// enharmonic keys have completely different signatures but are the same position on the circle
+	assert.Equal(t, 12, cSharpMajor.AccidentalDistance(dFlatMajor))
+	assert.Equal(t, 0, cSharpMajor.CircleDistance(dFlatMajor))
+}
+
+func TestCircle_SortKeysByDifficulty(t *testing.T) {
+	keys := make([]*Key, 0)
+	for _, name := range []string{"Eb", "Am", "F#", "Bb", "C", "Dm", "G", "E", "Gb"} {
+		key, _ := ParseKey(name)
+		keys = append(keys, key)
+	}
+
+	assert.Equal(t, []string{
+		"C major", "A minor", "G major", "D minor", "Bb major", "Eb major", "E major", "F# major", "Gb major",
+	}, keyNames(SortKeysByDifficulty(keys)))
+
+	// the original slice is left untouched
+	assert.Equal(t, "Eb major", keys[0].String())
+}
@@ -0,0 +1,292 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type HarmonicFunction string
+
+const (
+	DiatonicFunction          HarmonicFunction = "diatonic"
+	SecondaryDominantFunction HarmonicFunction = "secondary dominant"
+	BorrowedFunction          HarmonicFunction = "borrowed"
+	ChromaticFunction         HarmonicFunction = "chromatic"
+)
+
+type DiatonicChord struct {
+	// Degree is the 1-indexed scale degree the chord is built on
+	Degree  int
+	Numeral string
+	Chord   *Chord
+}
+
+type ChordAnalysis struct {
+	Chord    *Chord
+	Numeral  string
+	Function HarmonicFunction
+}
+
+type chordQuality struct {
+	intervals []Interval
+	// suffix used in chord symbols (e.g. m7b5) and suffix added to roman numerals (e.g. ø7)
+	suffix        string
+	numeralSuffix string
+	minor         bool
+}
+
+var chordQualities = []chordQuality{
+	{[]Interval{MajorThird, PerfectFifth}, "", "", false},
+	{[]Interval{MinorThird, PerfectFifth}, "m", "", true},
+	{[]Interval{MinorThird, DiminishedFifth}, "dim", "°", true},
+	{[]Interval{MajorThird, AugmentedFifth}, "aug", "+", false},
+	{[]Interval{MajorThird, PerfectFifth, MajorSeventh}, "maj7", "maj7", false},
+	{[]Interval{MajorThird, PerfectFifth, MinorSeventh}, "7", "7", false},
+	{[]Interval{MinorThird, PerfectFifth, MinorSeventh}, "m7", "7", true},
+	{[]Interval{MinorThird, PerfectFifth, MajorSeventh}, "mMaj7", "maj7", true},
+	{[]Interval{MinorThird, DiminishedFifth, MinorSeventh}, "m7b5", "ø7", true},
+	{[]Interval{MinorThird, DiminishedFifth, DiminishedSeventh}, "dim7", "°7", true},
+	{[]Interval{MajorThird, AugmentedFifth, MajorSeventh}, "maj7#5", "+maj7", false},
+	{[]Interval{MajorThird, AugmentedFifth, MinorSeventh}, "7#5", "+7", false},
+	{[]Interval{MajorThird, DiminishedFifth, MinorSeventh}, "7b5", "7b5", false},
+}
+
+var romanNumerals = []string{"I", "II", "III", "IV", "V", "VI", "VII"}
+
+// DiatonicChords stacks thirds on every degree of a heptatonic scale, returning its triads or,
+// when sevenths is set, its seventh chords.
+func DiatonicChords(scale *Scale, sevenths bool) ([]DiatonicChord, error) {
+	if len(scale.Notes) != lettersPerOctave {
+		return nil, fmt.Errorf("diatonic chords need a scale with 7 notes, %s has %d", scale.Type.Name, len(scale.Notes))
+	}
+
+	chordSize := 3
+	if sevenths {
+		chordSize = 4
+	}
+
+	diatonicChords := make([]DiatonicChord, 0, len(scale.Notes))
+	for i, root := range scale.Notes {
+		intervals := []Interval{PerfectUnison}
+		for third := 1; third < chordSize; third++ {
+			interval, err := root.IntervalTo(scale.Notes[(i+third*2)%len(scale.Notes)])
+			if err != nil {
+				return nil, err
+			}
+			intervals = append(intervals, interval)
+		}
+
+		chord, err := NewChord(root, intervals)
+		if err != nil {
+			return nil, err
+		}
+
+		quality, qualityFound := findChordQuality(chord)
+		if qualityFound {
+			chord.Symbol = EnglishNaming.NoteName(root) + quality.suffix
+		} else {
+			chord.Symbol = fmt.Sprintf("%s(%s)", EnglishNaming.NoteName(root), strings.Join(chord.Degrees()[1:], ","))
+		}
+
+		diatonicChords = append(diatonicChords, DiatonicChord{
+			Degree:  i + 1,
+			Numeral: romanNumeral(i, "", chord),
+			Chord:   chord,
+		})
+	}
+
+	return diatonicChords, nil
+}
+
+func (k *Key) DiatonicChords(sevenths bool) ([]DiatonicChord, error) {
+	return DiatonicChords(k.Scale(), sevenths)
+}
+
+// AnalyzeProgression parses a progression of chord symbols separated by spaces or bars
+// (e.g. "C Am | F G7") and returns their roman numeral analysis in the key.
+func (k *Key) AnalyzeProgression(progression string) ([]ChordAnalysis, error) {
+	analyses := make([]ChordAnalysis, 0)
+
+	for _, symbol := range strings.Fields(progression) {
+		if symbol == "|" {
+			continue
+		}
+
+		chord, err := ParseChord(symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		analysis, err := k.AnalyzeChord(chord)
+		if err != nil {
+			return nil, err
+		}
+		analyses = append(analyses, analysis)
+	}
+
+	return analyses, nil
+}
+
+// AnalyzeChord names a chord with a roman numeral relative to the key. Chords are checked, in
+// order, against the diatonic chords of the key (including the harmonic minor in minor keys),
+// chords borrowed from the parallel key and secondary dominants. Anything else is chromatic.
+func (k *Key) AnalyzeChord(chord *Chord) (ChordAnalysis, error) {
+	diatonicScales := []ScaleType{k.Scale().Type}
+	borrowedScales := []ScaleType{NaturalMinorScale}
+	if k.Mode == MinorKey {
+		diatonicScales = append(diatonicScales, HarmonicMinorScale)
+		borrowedScales = []ScaleType{MajorScale}
+	}
+
+	// diatonic chords
+	for _, scaleType := range diatonicScales {
+		if numeral, found := k.findChordInScale(chord, scaleType, false); found {
+			return ChordAnalysis{Chord: chord, Numeral: numeral, Function: DiatonicFunction}, nil
+		}
+	}
+
+	// chords borrowed from the parallel key
+	for _, scaleType := range borrowedScales {
+		if numeral, found := k.findChordInScale(chord, scaleType, true); found {
+			return ChordAnalysis{Chord: chord, Numeral: numeral, Function: BorrowedFunction}, nil
+		}
+	}
+
+	// secondary dominants, i.e. a major triad or dominant seventh a fifth above a diatonic chord
+	if quality, found := findChordQuality(chord); found && (quality.suffix == "" || quality.suffix == "7") {
+		target, err := chord.Root.Subtract(PerfectFifth)
+		if err != nil {
+			return ChordAnalysis{}, err
+		}
+
+		triads, err := k.DiatonicChords(false)
+		if err != nil {
+			return ChordAnalysis{}, err
+		}
+
+		for _, triad := range triads {
+			targetQuality, _ := findChordQuality(triad.Chord)
+			if triad.Degree == 1 || targetQuality.suffix == "dim" || !triad.Chord.Root.EnharmonicEquals(target) {
+				continue
+			}
+
+			return ChordAnalysis{
+				Chord:    chord,
+				Numeral:  fmt.Sprintf("V%s/%s", quality.numeralSuffix, triad.Numeral),
+				Function: SecondaryDominantFunction,
+			}, nil
+		}
+	}
+
+	degreeIndex, prefix, err := k.degreeOf(chord.Root)
+	if err != nil {
+		return ChordAnalysis{}, err
+	}
+
+	return ChordAnalysis{
+		Chord:    chord,
+		Numeral:  romanNumeral(degreeIndex, prefix, chord),
+		Function: ChromaticFunction,
+	}, nil
+}
+
+// findChordInScale looks for a triad or seventh chord of a scale built on the key's tonic with the
+// same root and quality as the given chord. Numerals of borrowed chords are prefixed with the
+// accidental needed relative to the key's own scale (e.g. bVI).
+func (k *Key) findChordInScale(chord *Chord, scaleType ScaleType, relativeToKey bool) (string, bool) {
+	scale, err := NewScale(&k.Tonic, scaleType)
+	if err != nil {
+		return "", false
+	}
+
+	for _, sevenths := range []bool{false, true} {
+		diatonicChords, err := DiatonicChords(scale, sevenths)
+		if err != nil {
+			return "", false
+		}
+
+		for _, diatonicChord := range diatonicChords {
+			if !diatonicChord.Chord.Root.EnharmonicEquals(chord.Root) || !sameChordQuality(diatonicChord.Chord, chord) {
+				continue
+			}
+
+			if !relativeToKey {
+				return diatonicChord.Numeral, true
+			}
+
+			degreeIndex, prefix, err := k.degreeOf(chord.Root)
+			if err != nil {
+				return "", false
+			}
+			return romanNumeral(degreeIndex, prefix, chord), true
+		}
+	}
+
+	return "", false
+}
+
+// degreeOf returns the 0-indexed scale degree sharing the note's letter and the accidental
+// needed to get from that degree to the note.
+func (k *Key) degreeOf(note *Note) (int, string, error) {
+	degreeIndex := mod(letterIndexOf(note.Name)-letterIndexOf(k.Tonic.Name), lettersPerOctave)
+	degreeNote := k.Scale().Notes[degreeIndex]
+
+	notePitchClass, err := note.PitchClass()
+	if err != nil {
+		return 0, "", err
+	}
+
+	degreePitchClass, _ := degreeNote.PitchClass()
+	switch mod(int(notePitchClass)-int(degreePitchClass)+6, PitchClassCount) - 6 {
+	case 0:
+		return degreeIndex, "", nil
+	case 1:
+		return degreeIndex, string(Sharp), nil
+	case -1:
+		return degreeIndex, string(Flat), nil
+	default:
+		return degreeIndex, "", fmt.Errorf("'%s' is too far from the %s scale to be analysed", note.String(), k.String())
+	}
+}
+
+func romanNumeral(degreeIndex int, prefix string, chord *Chord) string {
+	numeral := romanNumerals[degreeIndex]
+
+	quality, found := findChordQuality(chord)
+	if !found {
+		return prefix + numeral
+	}
+
+	if quality.minor {
+		numeral = strings.ToLower(numeral)
+	}
+
+	return prefix + numeral + quality.numeralSuffix
+}
+
+// findChordQuality matches the third, fifth and seventh of a chord (ignoring extensions) against
+// the known triad and seventh chord qualities.
+func findChordQuality(chord *Chord) (chordQuality, bool) {
+	coreIntervals := make([]Interval, 0)
+	for _, tone := range chord.Tones {
+		if tone.Interval.Number == 3 || tone.Interval.Number == 5 || tone.Interval.Number == 7 {
+			coreIntervals = append(coreIntervals, tone.Interval)
+		}
+	}
+
+	for _, quality := range chordQualities {
+		if slices.Equal(quality.intervals, coreIntervals) {
+			return quality, true
+		}
+	}
+
+	return chordQuality{}, false
+}
+
+func sameChordQuality(chord *Chord, anotherChord *Chord) bool {
+	quality, found := findChordQuality(chord)
+	anotherQuality, anotherFound := findChordQuality(anotherChord)
+
+	return found && anotherFound && quality.suffix == anotherQuality.suffix
+}
@@ -0,0 +1,114 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHarmony_DiatonicTriads(t *testing.T) {
+	key, _ := ParseKey("C")
+	chords, err := key.DiatonicChords(false)
+	assert.Nil(t, err)
+
+	numerals := make([]string, 0)
+	symbols := make([]string, 0)
+	for _, chord := range chords {
+		numerals = append(numerals, chord.Numeral)
+		symbols = append(symbols, chord.Chord.Symbol)
+	}
+
+	assert.Equal(t, []string{"I", "ii", "iii", "IV", "V", "vi", "vii°"}, numerals)
+	assert.Equal(t, []string{"C", "Dm", "Em", "F", "G", "Am", "Bdim"}, symbols)
+	assert.Equal(t, 5, chords[4].Degree)
+}
+
+func TestHarmony_DiatonicSevenths(t *testing.T) {
+	key, _ := ParseKey("Eb")
+	chords, err := key.DiatonicChords(true)
+	assert.Nil(t, err)
+
+	numerals := make([]string, 0)
+	symbols := make([]string, 0)
+	for _, chord := range chords {
+		numerals = append(numerals, chord.Numeral)
+		symbols = append(symbols, chord.Chord.Symbol)
+	}
+
+	assert.Equal(t, []string{"Imaj7", "ii7", "iii7", "IVmaj7", "V7", "vi7", "viiø7"}, numerals)
+	assert.Equal(t, []string{"Ebmaj7", "Fm7", "Gm7", "Abmaj7", "Bb7", "Cm7", "Dm7b5"}, symbols)
+}
+
+func TestHarmony_DiatonicChordsOfModes(t *testing.T) {
+	root, _ := NewNote(A, Natural)
+	scale, _ := NewScale(root, HarmonicMinorScale)
+
+	chords, err := DiatonicChords(scale, true)
+	assert.Nil(t, err)
+
+	numerals := make([]string, 0)
+	for _, chord := range chords {
+		numerals = append(numerals, chord.Numeral)
+	}
+	assert.Equal(t, []string{"imaj7", "iiø7", "III+maj7", "iv7", "V7", "VImaj7", "vii°7"}, numerals)
+
+	// scales that aren't heptatonic
+	scale, _ = NewScale(root, MinorPentatonicScale)
+	_, err = DiatonicChords(scale, false)
+	assert.Error(t, err)
+}
+
+func TestHarmony_AnalyzeProgression(t *testing.T) {
+	key, _ := ParseKey("C")
+	analyses, err := key.AnalyzeProgression("C Am | F G7")
+	assert.Nil(t, err)
+
+	numerals := make([]string, 0)
+	for _, analysis := range analyses {
+		numerals = append(numerals, analysis.Numeral)
+		assert.Equal(t, DiatonicFunction, analysis.Function)
+	}
+	assert.Equal(t, []string{"I", "vi", "IV", "V7"}, numerals)
+
+	// secondary dominants and borrowed chords
+	analyses, err = key.AnalyzeProgression("C A7 Dm D7 G E Am Fm Ab Bb F#m7b5")
+	assert.Nil(t, err)
+
+	expected := []struct {
+		numeral  string
+		function HarmonicFunction
+	}{
+		{"I", DiatonicFunction},
+		{"V7/ii", SecondaryDominantFunction},
+		{"ii", DiatonicFunction},
+		{"V7/V", SecondaryDominantFunction},
+		{"V", DiatonicFunction},
+		{"V/vi", SecondaryDominantFunction},
+		{"vi", DiatonicFunction},
+		{"iv", BorrowedFunction},
+		{"bVI", BorrowedFunction},
+		{"bVII", BorrowedFunction},
+		{"#ivø7", ChromaticFunction},
+	}
+
+	for i, analysis := range analyses {
+		assert.Equal(t, expected[i].numeral, analysis.Numeral, analysis.Chord.Symbol)
+		assert.Equal(t, expected[i].function, analysis.Function, analysis.Chord.Symbol)
+	}
+
+	_, err = key.AnalyzeProgression("C Xm")
+	assert.Error(t, err)
+}
+
+func TestHarmony_AnalyzeProgressionInMinorKey(t *testing.T) {
+	key, _ := ParseKey("Am")
+	analyses, err := key.AnalyzeProgression("Am Dm E7 Am G C F G#dim7 D")
+	assert.Nil(t, err)
+
+	numerals := make([]string, 0)
+	for _, analysis := range analyses {
+		numerals = append(numerals, analysis.Numeral)
+	}
+	assert.Equal(t, []string{"i", "iv", "V7", "i", "VII", "III", "VI", "vii°7", "IV"}, numerals)
+	assert.Equal(t, BorrowedFunction, analyses[8].Function)
+}
@@ -0,0 +1,166 @@
+package music
+
+import (
+	"fmt"
+	"sort"
+)
+
+type ChordCandidate struct {
+	// Name is the chord symbol, including the bass note for inversions and slash chords (e.g. C/E)
+	Name  string
+	Chord *Chord
+	// Inversion is 0 for root position, 1 when the bass is the second chord tone and so on
+	Inversion int
+	// OmitsFifth is set when the notes only match the chord without its perfect fifth
+	OmitsFifth bool
+}
+
+// identifiableChordSuffixes lists the chords IdentifyChord knows about, from the most common to
+// the least common, which is also the order used to rank candidates
+var identifiableChordSuffixes = []string{
+	"", "m", "7", "maj7", "m7", "dim", "aug", "sus4", "sus2", "5",
+	"6", "m6", "m7b5", "dim7", "7sus4", "add9", "madd9", "mMaj7",
+	"9", "maj9", "m9", "69", "7b9", "7#9", "7b5", "7#5", "maj7#5", "maj7#11",
+	"11", "m11", "13", "maj13", "m13", "13#11", "7b13",
+}
+
+// IdentifyChord names the chords that can be built from a set of notes, where the first note is
+// the one in the bass. Candidates are ranked so root position chords with all of their tones come
+// first, followed by inversions/slash chords and chords missing their fifth.
+func IdentifyChord(notes []*Note) ([]ChordCandidate, error) {
+	if len(notes) < 2 {
+		return nil, fmt.Errorf("at least two notes are needed to identify a chord")
+	}
+
+	bass := notes[0]
+	bassPitchClass, err := bass.PitchClass()
+	if err != nil {
+		return nil, err
+	}
+
+	// map[pitchClass]: note as spelled by the caller
+	pitchClasses := make(map[PitchClass]*Note)
+	roots := make([]*Note, 0)
+	for _, note := range notes {
+		pitchClass, err := note.PitchClass()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, alreadyAdded := pitchClasses[pitchClass]; !alreadyAdded {
+			pitchClasses[pitchClass] = note
+			roots = append(roots, note)
+		}
+	}
+
+	type rankedCandidate struct {
+		candidate   ChordCandidate
+		suffixIndex int
+		rootIndex   int
+	}
+	rankedCandidates := make([]rankedCandidate, 0)
+
+	for rootIndex, root := range roots {
+		if root.Symbol == DoubleSharp || root.Symbol == DoubleFlat {
+			continue
+		}
+
+		for suffixIndex, suffix := range identifiableChordSuffixes {
+			chord, err := ParseChord(EnglishNaming.NoteName(root) + suffix)
+			if err != nil {
+				continue
+			}
+
+			matches, omitsFifth := chordMatchesPitchClasses(chord, pitchClasses)
+			if !matches {
+				continue
+			}
+
+			name := chord.Symbol
+			inversion := 0
+			if !root.EnharmonicEquals(bass) {
+				name = fmt.Sprintf("%s/%s", chord.Symbol, EnglishNaming.NoteName(bass))
+				chord.Bass = bass
+				for i, tone := range chord.Tones {
+					tonePitchClass, _ := tone.Note.PitchClass()
+					if tonePitchClass == bassPitchClass {
+						inversion = i
+					}
+				}
+			}
+			chord.Symbol = name
+
+			rankedCandidates = append(rankedCandidates, rankedCandidate{
+				candidate: ChordCandidate{
+					Name:       name,
+					Chord:      chord,
+					Inversion:  inversion,
+					OmitsFifth: omitsFifth,
+				},
+				suffixIndex: suffixIndex,
+				rootIndex:   rootIndex,
+			})
+		}
+	}
+
+	sort.SliceStable(rankedCandidates, func(i, j int) bool {
+		a, b := rankedCandidates[i], rankedCandidates[j]
+
+		if a.candidate.OmitsFifth != b.candidate.OmitsFifth {
+			return !a.candidate.OmitsFifth
+		}
+
+		if (a.candidate.Inversion == 0) != (b.candidate.Inversion == 0) {
+			return a.candidate.Inversion == 0
+		}
+
+		if a.suffixIndex != b.suffixIndex {
+			return a.suffixIndex < b.suffixIndex
+		}
+
+		return a.rootIndex < b.rootIndex
+	})
+
+	candidates := make([]ChordCandidate, len(rankedCandidates))
+	for i, rankedCandidate := range rankedCandidates {
+		candidates[i] = rankedCandidate.candidate
+	}
+
+	return candidates, nil
+}
+
+// chordMatchesPitchClasses reports whether the chord is made of exactly the given pitch classes,
+// allowing the perfect fifth to be left out of chords with four or more tones.
+func chordMatchesPitchClasses(chord *Chord, pitchClasses map[PitchClass]*Note) (bool, bool) {
+	chordPitchClasses := make(map[PitchClass]bool)
+	var fifth *PitchClass
+
+	for _, tone := range chord.Tones {
+		pitchClass, err := tone.Note.PitchClass()
+		if err != nil {
+			return false, false
+		}
+
+		chordPitchClasses[pitchClass] = true
+		if tone.Interval == PerfectFifth {
+			fifth = &pitchClass
+		}
+	}
+
+	for pitchClass := range pitchClasses {
+		if !chordPitchClasses[pitchClass] {
+			return false, false
+		}
+	}
+
+	if len(chordPitchClasses) == len(pitchClasses) {
+		return true, false
+	}
+
+	if fifth == nil || len(chordPitchClasses) < 4 || len(chordPitchClasses)-1 != len(pitchClasses) {
+		return false, false
+	}
+
+	_, fifthPlayed := pitchClasses[*fifth]
+	return !fifthPlayed, !fifthPlayed
+}
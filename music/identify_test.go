@@ -0,0 +1,87 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifyChord(t *testing.T) {
+	testCases := []struct {
+		notes     []string
+		expected  string
+		inversion int
+	}{
+		{[]string{"C", "E", "G"}, "C", 0},
+		{[]string{"E", "G", "C"}, "C/E", 1},
+		{[]string{"G", "C", "E"}, "C/G", 2},
+		{[]string{"A", "C", "E", "G"}, "Am7", 0},
+		{[]string{"C", "E", "G", "A"}, "C6", 0},
+		{[]string{"F", "G", "B", "D"}, "G7/F", 3},
+		{[]string{"F#", "A", "C", "E"}, "F#m7b5", 0},
+		{[]string{"Bb", "D", "F", "A"}, "Bbmaj7", 0},
+		{[]string{"D", "A", "D"}, "D5", 0},
+		{[]string{"E", "G#", "D", "F#"}, "E9", 0},
+	}
+
+	for _, testCase := range testCases {
+		notes := make([]*Note, 0)
+		for _, name := range testCase.notes {
+			notes = append(notes, mustParseTestNote(t, name))
+		}
+
+		candidates, err := IdentifyChord(notes)
+		if !assert.Nil(t, err) || !assert.NotEmpty(t, candidates, testCase.expected) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expected, candidates[0].Name)
+		assert.Equal(t, testCase.inversion, candidates[0].Inversion, testCase.expected)
+		assert.Equal(t, candidates[0].Name, candidates[0].Chord.Symbol)
+		assert.True(t, candidates[0].Chord.Bass.Equals(notes[0]))
+	}
+}
+
+func TestIdentifyChord_RanksAlternatives(t *testing.T) {
+	notes := []*Note{
+		mustParseTestNote(t, "C"),
+		mustParseTestNote(t, "E"),
+		mustParseTestNote(t, "G"),
+		mustParseTestNote(t, "A"),
+	}
+
+	candidates, err := IdentifyChord(notes)
+	assert.Nil(t, err)
+
+	names := make([]string, 0)
+	for _, candidate := range candidates {
+		names = append(names, candidate.Name)
+	}
+	assert.Equal(t, []string{"C6", "Am7/C"}, names)
+
+	// the ninth chord without its fifth is still recognised, but flagged
+	notes = []*Note{
+		mustParseTestNote(t, "E"),
+		mustParseTestNote(t, "G#"),
+		mustParseTestNote(t, "D"),
+		mustParseTestNote(t, "F#"),
+	}
+
+	candidates, err = IdentifyChord(notes)
+	assert.Nil(t, err)
+	assert.True(t, candidates[0].OmitsFifth)
+}
+
+func TestIdentifyChord_Errors(t *testing.T) {
+	_, err := IdentifyChord([]*Note{mustParseTestNote(t, "C")})
+	assert.Error(t, err)
+
+	// notes that don't make a known chord
+	candidates, err := IdentifyChord([]*Note{
+		mustParseTestNote(t, "C"),
+		mustParseTestNote(t, "C#"),
+		mustParseTestNote(t, "D"),
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, candidates)
+}
@@ -0,0 +1,312 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type IntervalQuality int
+
+const (
+	Perfect IntervalQuality = iota
+	Major
+	Minor
+	Augmented
+	Diminished
+)
+
+const (
+	MaxIntervalNumber = 15
+	lettersPerOctave  = 7
+)
+
+var intervalQualitySymbols = map[IntervalQuality]string{
+	Perfect:    "P",
+	Major:      "M",
+	Minor:      "m",
+	Augmented:  "A",
+	Diminished: "d",
+}
+
+var intervalQualityNames = map[IntervalQuality]string{
+	Perfect:    "perfect",
+	Major:      "major",
+	Minor:      "minor",
+	Augmented:  "augmented",
+	Diminished: "diminished",
+}
+
+var intervalNumberNames = map[int]string{
+	1:  "unison",
+	2:  "second",
+	3:  "third",
+	4:  "fourth",
+	5:  "fifth",
+	6:  "sixth",
+	7:  "seventh",
+	8:  "octave",
+	9:  "ninth",
+	10: "tenth",
+	11: "eleventh",
+	12: "twelfth",
+	13: "thirteenth",
+	14: "fourteenth",
+	15: "double octave",
+}
+
+// semitones of the major/perfect interval for each simple interval number (unison to seventh)
+var simpleIntervalSemitones = []int{0, 2, 4, 5, 7, 9, 11}
+
+var naturalNoteOrder = []NaturalNote{C, D, E, F, G, A, B}
+
+type Interval struct {
+	Quality IntervalQuality
+	Number  int
+}
+
+var (
+	PerfectUnison     = Interval{Quality: Perfect, Number: 1}
+	MinorSecond       = Interval{Quality: Minor, Number: 2}
+	MajorSecond       = Interval{Quality: Major, Number: 2}
+	AugmentedSecond   = Interval{Quality: Augmented, Number: 2}
+	MinorThird        = Interval{Quality: Minor, Number: 3}
+	MajorThird        = Interval{Quality: Major, Number: 3}
+	PerfectFourth     = Interval{Quality: Perfect, Number: 4}
+	AugmentedFourth   = Interval{Quality: Augmented, Number: 4}
+	DiminishedFifth   = Interval{Quality: Diminished, Number: 5}
+	PerfectFifth      = Interval{Quality: Perfect, Number: 5}
+	AugmentedFifth    = Interval{Quality: Augmented, Number: 5}
+	MinorSixth        = Interval{Quality: Minor, Number: 6}
+	MajorSixth        = Interval{Quality: Major, Number: 6}
+	DiminishedSeventh = Interval{Quality: Diminished, Number: 7}
+	MinorSeventh      = Interval{Quality: Minor, Number: 7}
+	MajorSeventh      = Interval{Quality: Major, Number: 7}
+	PerfectOctave     = Interval{Quality: Perfect, Number: 8}
+	MinorNinth        = Interval{Quality: Minor, Number: 9}
+	MajorNinth        = Interval{Quality: Major, Number: 9}
+	AugmentedNinth    = Interval{Quality: Augmented, Number: 9}
+	PerfectEleventh   = Interval{Quality: Perfect, Number: 11}
+	AugmentedEleventh = Interval{Quality: Augmented, Number: 11}
+	MinorThirteenth   = Interval{Quality: Minor, Number: 13}
+	MajorThirteenth   = Interval{Quality: Major, Number: 13}
+	DoubleOctave      = Interval{Quality: Perfect, Number: 15}
+)
+
+func NewInterval(quality IntervalQuality, number int) (Interval, error) {
+	interval := Interval{Quality: quality, Number: number}
+
+	if number < 1 || number > MaxIntervalNumber {
+		return Interval{}, fmt.Errorf("interval number '%d' has to be between 1 and %d", number, MaxIntervalNumber)
+	}
+
+	if _, qualityExists := intervalQualitySymbols[quality]; !qualityExists {
+		return Interval{}, fmt.Errorf("interval quality '%d' doesn't exist", quality)
+	}
+
+	if interval.isPerfectType() && (quality == Major || quality == Minor) {
+		return Interval{}, fmt.Errorf("%s can't be major or minor", intervalNumberNames[number])
+	}
+
+	if !interval.isPerfectType() && quality == Perfect {
+		return Interval{}, fmt.Errorf("%s can't be perfect", intervalNumberNames[number])
+	}
+
+	if number == 1 && quality == Diminished {
+		return Interval{}, fmt.Errorf("unison can't be diminished")
+	}
+
+	return interval, nil
+}
+
+// ParseInterval parses the short interval notation such as "P5", "m3", "A4", "d7" or "M9".
+func ParseInterval(interval string) (Interval, error) {
+	interval = strings.TrimSpace(interval)
+	if len(interval) < 2 {
+		return Interval{}, fmt.Errorf("interval '%s' is too short, expected something like 'P5'", interval)
+	}
+
+	for quality, symbol := range intervalQualitySymbols {
+		if interval[0:1] != symbol {
+			continue
+		}
+
+		number, err := strconv.Atoi(interval[1:])
+		if err != nil {
+			return Interval{}, fmt.Errorf("error parsing interval number '%s': %v", interval, err)
+		}
+
+		return NewInterval(quality, number)
+	}
+
+	return Interval{}, fmt.Errorf("interval quality '%s' doesn't exist", interval[0:1])
+}
+
+func (i Interval) Semitones() int {
+	simpleIndex := (i.Number - 1) % lettersPerOctave
+	octaves := (i.Number - 1) / lettersPerOctave
+	semitones := simpleIntervalSemitones[simpleIndex] + octaves*PitchClassCount
+
+	switch i.Quality {
+	case Minor:
+		semitones--
+	case Augmented:
+		semitones++
+	case Diminished:
+		if i.isPerfectType() {
+			semitones--
+		} else {
+			semitones -= 2
+		}
+	}
+
+	return semitones
+}
+
+func (i Interval) IsCompound() bool {
+	return i.Number > 8
+}
+
+// Simple reduces compound intervals to their simple counterpart (e.g. M9 becomes M2). The
+// octave and double octave are both reduced to an octave.
+func (i Interval) Simple() Interval {
+	if !i.IsCompound() {
+		return i
+	}
+
+	number := (i.Number-1)%lettersPerOctave + 1
+	if number == 1 {
+		number = 8
+	}
+
+	return Interval{Quality: i.Quality, Number: number}
+}
+
+// Invert returns the interval that completes i to an octave (e.g. M3 becomes m6). Compound
+// intervals are reduced to their simple form before being inverted.
+func (i Interval) Invert() Interval {
+	simple := i.Simple()
+
+	invertedQualities := map[IntervalQuality]IntervalQuality{
+		Perfect:    Perfect,
+		Major:      Minor,
+		Minor:      Major,
+		Augmented:  Diminished,
+		Diminished: Augmented,
+	}
+
+	return Interval{
+		Quality: invertedQualities[simple.Quality],
+		Number:  9 - simple.Number,
+	}
+}
+
+func (i Interval) Name() string {
+	return fmt.Sprintf("%s %s", intervalQualityNames[i.Quality], intervalNumberNames[i.Number])
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("%s%d", intervalQualitySymbols[i.Quality], i.Number)
+}
+
+// isPerfectType reports whether the interval number is a unison, fourth, fifth or octave (or
+// their compound equivalents)
+func (i Interval) isPerfectType() bool {
+	simpleIndex := (i.Number - 1) % lettersPerOctave
+	return simpleIndex == 0 || simpleIndex == 3 || simpleIndex == 4
+}
+
+// Add returns the note found an interval above n, spelled according to the interval number so
+// that C + augmented fourth is F# while C + diminished fifth is Gb.
+func (n *Note) Add(interval Interval) (*Note, error) {
+	return n.moveBy(interval, 1)
+}
+
+// Subtract returns the note found an interval below n, spelled according to the interval number.
+func (n *Note) Subtract(interval Interval) (*Note, error) {
+	return n.moveBy(interval, -1)
+}
+
+func (n *Note) moveBy(interval Interval, direction int) (*Note, error) {
+	pitchClass, err := n.PitchClass()
+	if err != nil {
+		return nil, err
+	}
+
+	letterIndex := letterIndexOf(n.Name)
+	targetLetter := naturalNoteOrder[mod(letterIndex+direction*(interval.Number-1), lettersPerOctave)]
+	targetPitchClass := pitchClass.Transpose(direction * interval.Semitones())
+
+	// how far (in semitones) the target pitch class is from the natural letter
+	offset := mod(int(targetPitchClass)-naturalNoteSemitones[targetLetter]+6, PitchClassCount) - 6
+
+	for accidental, accidentalOffset := range accidentalSemitones {
+		if accidentalOffset == offset {
+			return NewNote(targetLetter, accidental)
+		}
+	}
+
+	return nil, fmt.Errorf("can't spell %s from '%s' with at most two accidentals", interval.Name(), n.String())
+}
+
+// IntervalTo returns the simple ascending interval going from n to another note, taking
+// their spelling into account (e.g. C to F# is an augmented fourth, C to Gb is a diminished fifth).
+func (n *Note) IntervalTo(anotherNote *Note) (Interval, error) {
+	pitchClass, err := n.PitchClass()
+	if err != nil {
+		return Interval{}, err
+	}
+
+	anotherPitchClass, err := anotherNote.PitchClass()
+	if err != nil {
+		return Interval{}, err
+	}
+
+	number := mod(letterIndexOf(anotherNote.Name)-letterIndexOf(n.Name), lettersPerOctave) + 1
+	semitones := pitchClass.Interval(anotherPitchClass)
+	difference := mod(semitones-simpleIntervalSemitones[number-1]+6, PitchClassCount) - 6
+
+	// a unison going downwards is an octave going upwards (e.g. C to Cb is a diminished octave)
+	if number == 1 && difference < 0 {
+		number = 8
+	}
+
+	interval := Interval{Number: number}
+	if interval.isPerfectType() {
+		switch difference {
+		case 0:
+			interval.Quality = Perfect
+		case 1:
+			interval.Quality = Augmented
+		case -1:
+			interval.Quality = Diminished
+		default:
+			return Interval{}, fmt.Errorf("interval from '%s' to '%s' isn't supported", n.String(), anotherNote.String())
+		}
+	} else {
+		switch difference {
+		case 0:
+			interval.Quality = Major
+		case -1:
+			interval.Quality = Minor
+		case 1:
+			interval.Quality = Augmented
+		case -2:
+			interval.Quality = Diminished
+		default:
+			return Interval{}, fmt.Errorf("interval from '%s' to '%s' isn't supported", n.String(), anotherNote.String())
+		}
+	}
+
+	return interval, nil
+}
+
+func letterIndexOf(name NaturalNote) int {
+	for i, letter := range naturalNoteOrder {
+		if letter == name {
+			return i
+		}
+	}
+
+	return -1
+}
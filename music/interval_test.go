@@ -0,0 +1,188 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterval_NewInterval(t *testing.T) {
+	interval, err := NewInterval(Major, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, MajorThird, interval)
+
+	interval, err = NewInterval(Perfect, 15)
+	assert.Nil(t, err)
+	assert.Equal(t, DoubleOctave, interval)
+
+	// perfect intervals can't be major/minor and vice-versa
+	_, err = NewInterval(Major, 5)
+	assert.Error(t, err)
+
+	_, err = NewInterval(Minor, 11)
+	assert.Error(t, err)
+
+	_, err = NewInterval(Perfect, 3)
+	assert.Error(t, err)
+
+	// out of range
+	_, err = NewInterval(Perfect, 0)
+	assert.Error(t, err)
+
+	_, err = NewInterval(Perfect, 16)
+	assert.Error(t, err)
+
+	_, err = NewInterval(Diminished, 1)
+	assert.Error(t, err)
+}
+
+func TestInterval_ParseInterval(t *testing.T) {
+	interval, err := ParseInterval("P5")
+	assert.Nil(t, err)
+	assert.Equal(t, PerfectFifth, interval)
+
+	interval, err = ParseInterval("m3")
+	assert.Nil(t, err)
+	assert.Equal(t, MinorThird, interval)
+
+	interval, err = ParseInterval("M9")
+	assert.Nil(t, err)
+	assert.Equal(t, MajorNinth, interval)
+
+	interval, err = ParseInterval("A11")
+	assert.Nil(t, err)
+	assert.Equal(t, AugmentedEleventh, interval)
+
+	for _, invalid := range []string{"", "P", "X5", "M5", "Pfive"} {
+		_, err = ParseInterval(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestInterval_Semitones(t *testing.T) {
+	assert.Equal(t, 0, PerfectUnison.Semitones())
+	assert.Equal(t, 1, MinorSecond.Semitones())
+	assert.Equal(t, 4, MajorThird.Semitones())
+	assert.Equal(t, 6, AugmentedFourth.Semitones())
+	assert.Equal(t, 6, DiminishedFifth.Semitones())
+	assert.Equal(t, 9, DiminishedSeventh.Semitones())
+	assert.Equal(t, 12, PerfectOctave.Semitones())
+	assert.Equal(t, 14, MajorNinth.Semitones())
+	assert.Equal(t, 18, AugmentedEleventh.Semitones())
+	assert.Equal(t, 21, MajorThirteenth.Semitones())
+	assert.Equal(t, 24, DoubleOctave.Semitones())
+}
+
+func TestInterval_Invert(t *testing.T) {
+	assert.Equal(t, MinorSixth, MajorThird.Invert())
+	assert.Equal(t, PerfectFourth, PerfectFifth.Invert())
+	assert.Equal(t, DiminishedFifth, AugmentedFourth.Invert())
+	assert.Equal(t, PerfectOctave, PerfectUnison.Invert())
+	assert.Equal(t, PerfectUnison, PerfectOctave.Invert())
+
+	// compound intervals are reduced first
+	assert.Equal(t, MinorSeventh, MajorNinth.Invert())
+	assert.Equal(t, PerfectUnison, DoubleOctave.Invert())
+}
+
+func TestInterval_Simple(t *testing.T) {
+	assert.False(t, PerfectOctave.IsCompound())
+	assert.True(t, MinorNinth.IsCompound())
+
+	assert.Equal(t, MinorSecond, MinorNinth.Simple())
+	assert.Equal(t, AugmentedFourth, AugmentedEleventh.Simple())
+	assert.Equal(t, PerfectOctave, DoubleOctave.Simple())
+	assert.Equal(t, MajorThird, MajorThird.Simple())
+}
+
+func TestInterval_String(t *testing.T) {
+	assert.Equal(t, "A4", AugmentedFourth.String())
+	assert.Equal(t, "augmented fourth", AugmentedFourth.Name())
+	assert.Equal(t, "m13", MinorThirteenth.String())
+	assert.Equal(t, "perfect double octave", DoubleOctave.Name())
+}
+
+func TestNote_Add(t *testing.T) {
+	testCases := []struct {
+		root     string
+		interval Interval
+		expected string
+	}{
+		{"C", AugmentedFourth, "F#"},
+		{"C", DiminishedFifth, "Gb"},
+		{"E", MinorSecond, "F"},
+		{"B", MajorThird, "D#"},
+		{"F#", MajorSeventh, "E#"},
+		{"Db", DiminishedFifth, "Abb"},
+		{"G#", MajorSeventh, "Fx"},
+		{"A", MajorNinth, "B"},
+		{"Bb", PerfectOctave, "Bb"},
+		{"C", DiminishedSeventh, "Bbb"},
+	}
+
+	for _, testCase := range testCases {
+		root := mustParseTestNote(t, testCase.root)
+		note, err := root.Add(testCase.interval)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, note.String(), "%s + %s", testCase.root, testCase.interval)
+	}
+
+	// beyond double accidentals
+	root := mustParseTestNote(t, "Fbb")
+	_, err := root.Add(DiminishedFifth)
+	assert.Error(t, err)
+}
+
+func TestNote_Subtract(t *testing.T) {
+	root := mustParseTestNote(t, "C")
+
+	note, err := root.Subtract(MajorThird)
+	assert.Nil(t, err)
+	assert.Equal(t, "Ab", note.String())
+
+	note, err = root.Subtract(PerfectFifth)
+	assert.Nil(t, err)
+	assert.Equal(t, "F", note.String())
+}
+
+func TestNote_IntervalTo(t *testing.T) {
+	testCases := []struct {
+		from     string
+		to       string
+		expected Interval
+	}{
+		{"C", "F#", AugmentedFourth},
+		{"C", "Gb", DiminishedFifth},
+		{"E", "C", MinorSixth},
+		{"C", "C", PerfectUnison},
+		{"C", "Cb", Interval{Quality: Diminished, Number: 8}},
+		{"C", "C#", Interval{Quality: Augmented, Number: 1}},
+		{"A", "G", MinorSeventh},
+		{"C", "Bbb", DiminishedSeventh},
+	}
+
+	for _, testCase := range testCases {
+		from := mustParseTestNote(t, testCase.from)
+		to := mustParseTestNote(t, testCase.to)
+
+		interval, err := from.IntervalTo(to)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, interval, "%s to %s", testCase.from, testCase.to)
+	}
+}
+
+func mustParseTestNote(t *testing.T, name string) *Note {
+	t.Helper()
+
+	symbol := Natural
+	if len(name) > 1 {
+		symbol = Accidental(name[1:])
+	}
+
+	note, err := NewNote(NaturalNote(name[0:1]), symbol)
+	if err != nil {
+		t.Fatalf("invalid test note '%s': %v", name, err)
+	}
+
+	return note
+}
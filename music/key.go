@@ -0,0 +1,174 @@
+package music
+
+import (
+	"fmt"
+	"strings"
+)
+
+type KeyMode int
+
+const (
+	MajorKey KeyMode = iota
+	MinorKey
+)
+
+const (
+	// keys with more than 7 sharps or flats would need double accidentals in their signature
+	maxKeySignatureAccidentals = 7
+	// a minor key has 3 fewer sharps (or 3 more flats) than the major key on the same tonic
+	minorKeyFifthsOffset = -3
+)
+
+// position of each natural note on the line of fifths, relative to C
+var naturalNoteFifths = map[NaturalNote]int{
+	F: -1,
+	C: 0,
+	G: 1,
+	D: 2,
+	A: 3,
+	E: 4,
+	B: 5,
+}
+
+var accidentalFifths = map[Accidental]int{
+	Natural:     0,
+	Sharp:       7,
+	Flat:        -7,
+	DoubleSharp: 14,
+	DoubleFlat:  -14,
+}
+
+var sharpsOrder = []NaturalNote{F, C, G, D, A, E, B}
+var flatsOrder = []NaturalNote{B, E, A, D, G, C, F}
+
+type Key struct {
+	Tonic Note
+	Mode  KeyMode
+}
+
+func NewKey(tonic *Note, mode KeyMode) (*Key, error) {
+	if mode != MajorKey && mode != MinorKey {
+		return nil, fmt.Errorf("key mode '%d' doesn't exist", mode)
+	}
+
+	key := &Key{
+		Tonic: *tonic,
+		Mode:  mode,
+	}
+
+	if _, err := tonic.PitchClass(); err != nil {
+		return nil, err
+	}
+
+	if fifths := key.Fifths(); fifths < -maxKeySignatureAccidentals || fifths > maxKeySignatureAccidentals {
+		return nil, fmt.Errorf("key of %s has a theoretical key signature (%d accidentals)", key.String(), abs(fifths))
+	}
+
+	return key, nil
+}
+
+// ParseKey parses keys written like "C", "Am", "F# minor", "B♭ major" or "ebm".
+func ParseKey(key string) (*Key, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, fmt.Errorf("key is empty")
+	}
+
+	tonic, consumed, parseErr := scanNote(key, defaultNamingSystem)
+	if parseErr != nil {
+		return nil, fmt.Errorf("error parsing key '%s': %v", key, parseErr)
+	}
+	remaining := key[consumed:]
+
+	var mode KeyMode
+	switch strings.ToLower(strings.TrimSpace(remaining)) {
+	case "", "maj", "major":
+		mode = MajorKey
+	case "m", "min", "minor":
+		mode = MinorKey
+	default:
+		return nil, fmt.Errorf("error parsing key '%s': unknown mode '%s'", key, strings.TrimSpace(remaining))
+	}
+
+	return NewKey(tonic, mode)
+}
+
+// Fifths returns the number of sharps (positive) or flats (negative) in the key signature.
+func (k *Key) Fifths() int {
+	fifths := lineOfFifths(&k.Tonic)
+	if k.Mode == MinorKey {
+		fifths += minorKeyFifthsOffset
+	}
+
+	return fifths
+}
+
+// Accidentals returns the sharps or flats of the key signature in the order they are written.
+func (k *Key) Accidentals() []*Note {
+	fifths := k.Fifths()
+	accidentals := make([]*Note, 0)
+
+	for i := 0; i < abs(fifths); i++ {
+		var note *Note
+		if fifths > 0 {
+			note, _ = NewNote(sharpsOrder[i], Sharp)
+		} else {
+			note, _ = NewNote(flatsOrder[i], Flat)
+		}
+		accidentals = append(accidentals, note)
+	}
+
+	return accidentals
+}
+
+// Scale returns the major or natural minor scale of the key.
+func (k *Key) Scale() *Scale {
+	scaleType := MajorScale
+	if k.Mode == MinorKey {
+		scaleType = NaturalMinorScale
+	}
+
+	// keys are limited to 7 accidentals, so their scales can always be spelled
+	scale, _ := NewScale(&k.Tonic, scaleType)
+	return scale
+}
+
+// Spell returns how a pitch class is written in this key. Notes of the key's scale are spelled as
+// in the scale (e.g. A# is Bb in F major), while chromatic notes use sharps in sharp keys and flats
+// in flat keys.
+func (k *Key) Spell(pitchClass PitchClass) *Note {
+	for _, note := range k.Scale().Notes {
+		if notePitchClass, _ := note.PitchClass(); notePitchClass == pitchClass {
+			return note
+		}
+	}
+
+	note := pitchClass.Note()
+	if k.Fifths() >= 0 || note.Symbol != Sharp {
+		return note
+	}
+
+	for _, enharmonic := range note.EnharmonicNames {
+		if enharmonic.Symbol == Flat {
+			flatNote, _ := NewNote(enharmonic.Name, enharmonic.Symbol)
+			return flatNote
+		}
+	}
+
+	return note
+}
+
+func (k *Key) String() string {
+	if k.Mode == MinorKey {
+		return fmt.Sprintf("%s minor", k.Tonic.String())
+	}
+
+	return fmt.Sprintf("%s major", k.Tonic.String())
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,101 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey_ParseKey(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected string
+		fifths   int
+	}{
+		{"C", "C major", 0},
+		{"Am", "A minor", 0},
+		{"F# minor", "F# minor", 3},
+		{"Bb major", "Bb major", -2},
+		{"ebm", "Eb minor", -6},
+		{"C#", "C# major", 7},
+		{"Cb", "Cb major", -7},
+		{"A#m", "A# minor", 7},
+		{"abmin", "Ab minor", -7},
+	}
+
+	for _, testCase := range testCases {
+		key, err := ParseKey(testCase.key)
+		if !assert.Nil(t, err, testCase.key) {
+			continue
+		}
+
+		assert.Equal(t, testCase.expected, key.String())
+		assert.Equal(t, testCase.fifths, key.Fifths(), testCase.key)
+	}
+
+	// theoretical keys and invalid input
+	for _, invalid := range []string{"", "H", "G#", "Fb", "Gbm", "C dorian"} {
+		_, err := ParseKey(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestKey_FifteenKeySignatures(t *testing.T) {
+	signatures := make(map[int]bool)
+	for _, name := range []string{"C", "G", "D", "A", "E", "B", "F#", "C#", "F", "Bb", "Eb", "Ab", "Db", "Gb", "Cb"} {
+		key, err := ParseKey(name)
+		assert.Nil(t, err)
+		signatures[key.Fifths()] = true
+	}
+
+	assert.Len(t, signatures, 15)
+}
+
+func TestKey_Accidentals(t *testing.T) {
+	key, _ := ParseKey("E")
+	assert.Equal(t, []string{"F#", "C#", "G#", "D#"}, noteNames(key.Accidentals()))
+
+	key, _ = ParseKey("Fm")
+	assert.Equal(t, []string{"Bb", "Eb", "Ab", "Db"}, noteNames(key.Accidentals()))
+
+	key, _ = ParseKey("C")
+	assert.Empty(t, key.Accidentals())
+}
+
+func TestKey_Spell(t *testing.T) {
+	aSharp, _ := NewPitchClass(A, Sharp)
+	fSharp, _ := NewPitchClass(F, Sharp)
+	b, _ := NewPitchClass(B, Natural)
+
+	key, _ := ParseKey("F")
+	assert.Equal(t, "Bb", key.Spell(aSharp).String())
+	// chromatic notes in flat keys use flats
+	assert.Equal(t, "Gb", key.Spell(fSharp).String())
+	assert.Equal(t, "B", key.Spell(b).String())
+
+	key, _ = ParseKey("D")
+	assert.Equal(t, "F#", key.Spell(fSharp).String())
+	assert.Equal(t, "A#", key.Spell(aSharp).String())
+
+	// notes of the scale keep their unusual spelling
+	key, _ = ParseKey("Gb")
+	assert.Equal(t, "Cb", key.Spell(b).String())
+
+	key, _ = ParseKey("C#")
+	c, _ := NewPitchClass(C, Natural)
+	assert.Equal(t, "B#", key.Spell(c).String())
+}
+
+func TestKey_Scale(t *testing.T) {
+	key, _ := ParseKey("Em")
+	assert.Equal(t, []string{"E", "F#", "G", "A", "B", "C", "D"}, noteNames(key.Scale().Notes))
+}
+
+func noteNames(notes []*Note) []string {
+	names := make([]string, len(notes))
+	for i, note := range notes {
+		names[i] = note.String()
+	}
+
+	return names
+}
@@ -0,0 +1,150 @@
+package music
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamingSystem decides how note names are written and read (e.g. "Bb", "B♭", "B" in German or
+// "Sib" in fixed-do solfège).
+type NamingSystem struct {
+	Name  string
+	spell func(name NaturalNote, symbol Accidental) string
+}
+
+var EnglishNaming = &NamingSystem{
+	Name: "english",
+	spell: func(name NaturalNote, symbol Accidental) string {
+		return string(name) + string(symbol)
+	},
+}
+
+var UnicodeNaming = &NamingSystem{
+	Name: "unicode",
+	spell: func(name NaturalNote, symbol Accidental) string {
+		return string(name) + unicodeAccidentals[symbol]
+	},
+}
+
+// GermanNaming uses H for B and B for Bb, with sharps and flats written as the "is" and "es"
+// suffixes (e.g. Fis, Des, Es, As).
+var GermanNaming = &NamingSystem{
+	Name: "german",
+	spell: func(name NaturalNote, symbol Accidental) string {
+		if name == B {
+			switch symbol {
+			case Flat:
+				return "B"
+			case DoubleFlat:
+				return "Heses"
+			}
+			name = "H"
+		}
+
+		// vowels absorb the "e" of the flat suffix
+		flatSuffix := "es"
+		if name == E || name == A {
+			flatSuffix = "s"
+		}
+
+		switch symbol {
+		case Sharp:
+			return string(name) + "is"
+		case DoubleSharp:
+			return string(name) + "isis"
+		case Flat:
+			return string(name) + flatSuffix
+		case DoubleFlat:
+			return string(name) + flatSuffix + "es"
+		default:
+			return string(name)
+		}
+	},
+}
+
+// SolfegeNaming is fixed-do solfège, where Do is always C.
+var SolfegeNaming = &NamingSystem{
+	Name: "solfege",
+	spell: func(name NaturalNote, symbol Accidental) string {
+		return solfegeSyllables[name] + string(symbol)
+	},
+}
+
+var namingSystems = []*NamingSystem{EnglishNaming, UnicodeNaming, GermanNaming, SolfegeNaming}
+
+var defaultNamingSystem = EnglishNaming
+
+var unicodeAccidentals = map[Accidental]string{
+	Natural:     "",
+	Sharp:       "♯",
+	Flat:        "♭",
+	DoubleSharp: "𝄪",
+	DoubleFlat:  "𝄫",
+}
+
+var solfegeSyllables = map[NaturalNote]string{
+	C: "Do",
+	D: "Re",
+	E: "Mi",
+	F: "Fa",
+	G: "Sol",
+	A: "La",
+	B: "Si",
+}
+
+func NamingSystems() []*NamingSystem {
+	return append([]*NamingSystem{}, namingSystems...)
+}
+
+func FindNamingSystem(name string) (*NamingSystem, error) {
+	normalizedName := strings.ToLower(strings.TrimSpace(name))
+	for _, system := range namingSystems {
+		if system.Name == normalizedName {
+			return system, nil
+		}
+	}
+
+	return nil, fmt.Errorf("naming system '%s' doesn't exist", name)
+}
+
+// DefaultNamingSystem returns the naming system used by Note.String and the note parsers.
+func DefaultNamingSystem() *NamingSystem {
+	return defaultNamingSystem
+}
+
+func SetDefaultNamingSystem(system *NamingSystem) {
+	defaultNamingSystem = system
+}
+
+func (s *NamingSystem) NoteName(note *Note) string {
+	return s.spell(note.Name, note.Symbol)
+}
+
+// scanNote reads the longest spelling of this naming system found at the start of the input,
+// ignoring case. It returns the number of bytes consumed, or 0 if nothing matched.
+func (s *NamingSystem) scanNote(input string) (*Note, int) {
+	var longestNote *Note
+	longestMatch := 0
+
+	for _, name := range naturalNoteOrder {
+		for _, symbol := range []Accidental{Natural, Sharp, Flat, DoubleSharp, DoubleFlat} {
+			spelling := s.spell(name, symbol)
+			if len(spelling) <= longestMatch || len(spelling) > len(input) || !strings.EqualFold(input[:len(spelling)], spelling) {
+				continue
+			}
+
+			note, err := NewNote(name, symbol)
+			if err != nil {
+				continue
+			}
+			longestNote = note
+			longestMatch = len(spelling)
+		}
+	}
+
+	return longestNote, longestMatch
+}
+
+func (s *NamingSystem) String() string {
+	return s.Name
+}
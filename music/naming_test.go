@@ -0,0 +1,144 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamingSystem_NoteName(t *testing.T) {
+	testCases := []struct {
+		name    NaturalNote
+		symbol  Accidental
+		english string
+		unicode string
+		german  string
+		solfege string
+	}{
+		{C, Natural, "C", "C", "C", "Do"},
+		{C, Sharp, "C#", "C♯", "Cis", "Do#"},
+		{D, Flat, "Db", "D♭", "Des", "Reb"},
+		{E, Flat, "Eb", "E♭", "Es", "Mib"},
+		{A, Flat, "Ab", "A♭", "As", "Lab"},
+		{B, Natural, "B", "B", "H", "Si"},
+		{B, Flat, "Bb", "B♭", "B", "Sib"},
+		{B, Sharp, "B#", "B♯", "His", "Si#"},
+		{B, DoubleFlat, "Bbb", "B𝄫", "Heses", "Sibb"},
+		{F, DoubleSharp, "Fx", "F𝄪", "Fisis", "Fax"},
+		{E, DoubleFlat, "Ebb", "E𝄫", "Eses", "Mibb"},
+		{G, Natural, "G", "G", "G", "Sol"},
+	}
+
+	for _, testCase := range testCases {
+		note, err := NewNote(testCase.name, testCase.symbol)
+		assert.Nil(t, err)
+
+		assert.Equal(t, testCase.english, EnglishNaming.NoteName(note))
+		assert.Equal(t, testCase.unicode, UnicodeNaming.NoteName(note))
+		assert.Equal(t, testCase.german, GermanNaming.NoteName(note))
+		assert.Equal(t, testCase.solfege, SolfegeNaming.NoteName(note))
+	}
+}
+
+func TestNamingSystem_FindNamingSystem(t *testing.T) {
+	system, err := FindNamingSystem(" German ")
+	assert.Nil(t, err)
+	assert.Equal(t, GermanNaming, system)
+
+	_, err = FindNamingSystem("klingon")
+	assert.Error(t, err)
+
+	assert.Len(t, NamingSystems(), 4)
+}
+
+func TestNamingSystem_DefaultNamingSystem(t *testing.T) {
+	defer SetDefaultNamingSystem(EnglishNaming)
+
+	bFlat, _ := NewNote(B, Flat)
+	pitch := NewPitch(bFlat, 3)
+	key, _ := NewKey(bFlat, MajorKey)
+
+	SetDefaultNamingSystem(GermanNaming)
+	assert.Equal(t, GermanNaming, DefaultNamingSystem())
+	assert.Equal(t, "B", bFlat.String())
+	assert.Equal(t, "B3", pitch.String())
+	assert.Equal(t, "B major", key.String())
+
+	SetDefaultNamingSystem(UnicodeNaming)
+	assert.Equal(t, "B♭3", pitch.String())
+
+	SetDefaultNamingSystem(SolfegeNaming)
+	assert.Equal(t, "Sib3", pitch.String())
+	assert.Equal(t, "Sib major", key.String())
+}
+
+func TestNamingSystem_Parse(t *testing.T) {
+	defer SetDefaultNamingSystem(EnglishNaming)
+
+	SetDefaultNamingSystem(GermanNaming)
+	testCases := map[string]string{
+		"H":     "B",
+		"B":     "Bb",
+		"b":     "Bb",
+		"Fis":   "F#",
+		"es":    "Eb",
+		"As":    "Ab",
+		"Heses": "Bbb",
+		"C#":    "C#",
+		"E":     "E",
+	}
+	for input, expected := range testCases {
+		note, err := ParseNote(input)
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected, EnglishNaming.NoteName(note), input)
+		}
+	}
+
+	pitch, err := ParsePitch("Fis4")
+	assert.Nil(t, err)
+	assert.Equal(t, 66, pitch.MIDI())
+
+	key, err := ParseKey("Hm")
+	assert.Nil(t, err)
+	assert.Equal(t, MinorKey, key.Mode)
+	assert.Equal(t, B, key.Tonic.Name)
+	assert.Equal(t, Natural, key.Tonic.Symbol)
+
+	// naming systems can be picked explicitly, whatever the default one is
+	note, err := EnglishNaming.ParseNote("B")
+	assert.Nil(t, err)
+	assert.Equal(t, "B", EnglishNaming.NoteName(note))
+
+	pitch, err = EnglishNaming.ParsePitch("B3")
+	assert.Nil(t, err)
+	assert.Equal(t, 59, pitch.MIDI())
+
+	_, err = EnglishNaming.ParseNote("Fis")
+	assert.Error(t, err)
+
+	// chord symbols keep using English names
+	chord, err := ParseChord("Esus4")
+	assert.Nil(t, err)
+	assert.Equal(t, E, chord.Root.Name)
+	assert.Equal(t, Natural, chord.Root.Symbol)
+
+	SetDefaultNamingSystem(SolfegeNaming)
+	testCases = map[string]string{
+		"Do":   "C",
+		"sol#": "G#",
+		"Sib":  "Bb",
+		"Mibb": "Ebb",
+		"La":   "A",
+	}
+	for input, expected := range testCases {
+		note, err := ParseNote(input)
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected, EnglishNaming.NoteName(note), input)
+		}
+	}
+
+	key, err = ParseKey("Re minor")
+	assert.Nil(t, err)
+	assert.Equal(t, D, key.Tonic.Name)
+	assert.Equal(t, MinorKey, key.Mode)
+}
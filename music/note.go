@@ -1,9 +1,5 @@
 package music
 
-import (
-	"fmt"
-)
-
 type NaturalNote string
 
 const (
@@ -19,9 +15,11 @@ const (
 type Accidental string
 
 const (
-	Natural Accidental = ""
-	Sharp   Accidental = "#"
-	Flat    Accidental = "b"
+	Natural     Accidental = ""
+	Sharp       Accidental = "#"
+	Flat        Accidental = "b"
+	DoubleSharp Accidental = "x"
+	DoubleFlat  Accidental = "bb"
 )
 
 type EnharmonicType struct {
@@ -55,67 +53,95 @@ var notes = []Note{
 	{Name: B, Symbol: Natural, EnharmonicNames: []EnharmonicType{{Name: C, Symbol: Flat}}},
 }
 
-func FindNote(name NaturalNote, symbol Accidental) (*Note, error) {
-	for _, note := range notes {
-		if note.Name == name && note.Symbol == symbol {
-			return &note, nil
-		}
+// NewNote returns a note spelled exactly as requested (e.g. Gb rather than F#), keeping the
+// remaining spellings of the same pitch class as its enharmonic names.
+func NewNote(name NaturalNote, symbol Accidental) (*Note, error) {
+	pitchClass, err := NewPitchClass(name, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	tableNote := notes[pitchClass]
+	spellings := append([]EnharmonicType{{Name: tableNote.Name, Symbol: tableNote.Symbol}}, tableNote.EnharmonicNames...)
 
-		for _, eNote := range note.EnharmonicNames {
-			if eNote.Name == name && eNote.Symbol == symbol {
-				return &note, nil
-			}
+	enharmonicNames := make([]EnharmonicType, 0)
+	for _, spelling := range spellings {
+		if spelling.Name != name || spelling.Symbol != symbol {
+			enharmonicNames = append(enharmonicNames, spelling)
 		}
 	}
 
-	return nil, fmt.Errorf("note '%s%s' not found", name, symbol)
+	return &Note{
+		Name:            name,
+		Symbol:          symbol,
+		EnharmonicNames: enharmonicNames,
+	}, nil
+}
+
+func FindNote(name NaturalNote, symbol Accidental) (*Note, error) {
+	pitchClass, err := NewPitchClass(name, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return pitchClass.Note(), nil
 }
 
 func (n *Note) NextHalfStepNote() (*Note, error) {
-	for i, note := range notes {
-		if n.Equals(&note) {
-			if i == len(notes)-1 {
-				return &notes[0], nil
-			}
-			return &notes[i+1], nil
-		}
+	pitchClass, err := n.PitchClass()
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("note '%s' not found", n.Name)
+	return pitchClass.Transpose(1).Note(), nil
 }
 
 func (n *Note) NextWholeStepNote() (*Note, error) {
-	firstStep, err := n.NextHalfStepNote()
+	pitchClass, err := n.PitchClass()
 	if err != nil {
 		return nil, err
 	}
-	return firstStep.NextHalfStepNote()
+
+	return pitchClass.Transpose(2).Note(), nil
 }
 
 func (n *Note) PreviousHalfStepNote() (*Note, error) {
-	for i, note := range notes {
-		if n.Equals(&note) {
-			if i == 0 {
-				return &notes[len(notes)-1], nil
-			}
-			return &notes[i-1], nil
-		}
+	pitchClass, err := n.PitchClass()
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("note '%s' not found", n.Name)
+
+	return pitchClass.Transpose(-1).Note(), nil
 }
 
 func (n *Note) PreviousWholeStepNote() (*Note, error) {
-	firstStep, err := n.PreviousHalfStepNote()
+	pitchClass, err := n.PitchClass()
 	if err != nil {
 		return nil, err
 	}
-	return firstStep.PreviousHalfStepNote()
+
+	return pitchClass.Transpose(-2).Note(), nil
 }
 
+// Equals reports whether both notes are spelled the same way, so C# and Db aren't equal. Use
+// EnharmonicEquals to compare how notes sound.
 func (n *Note) Equals(anotherNote *Note) bool {
-	if n.Name == anotherNote.Name && n.Symbol == anotherNote.Symbol {
-		return true
+	return n.Name == anotherNote.Name && n.Symbol == anotherNote.Symbol
+}
+
+// EnharmonicEquals reports whether both notes sound the same, regardless of how they are spelled.
+func (n *Note) EnharmonicEquals(anotherNote *Note) bool {
+	pitchClass, err := n.PitchClass()
+	anotherPitchClass, anotherErr := anotherNote.PitchClass()
+
+	if err != nil || anotherErr != nil {
+		return n.Equals(anotherNote)
 	}
 
-	return false
+	return pitchClass == anotherPitchClass
+}
+
+// String spells the note using the default naming system.
+func (n *Note) String() string {
+	return defaultNamingSystem.NoteName(n)
 }
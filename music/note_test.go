@@ -123,3 +123,40 @@ func TestNote_PreviousWholeStepNote(t *testing.T) {
 		[]EnharmonicType{{Name: B, Symbol: Flat}},
 	}))
 }
+
+func TestNote_NewNote(t *testing.T) {
+	// spelling is kept as requested
+	note, err := NewNote(G, Flat)
+	assert.Nil(t, err)
+	assert.True(t, reflect.DeepEqual(note, &Note{
+		G,
+		Flat,
+		[]EnharmonicType{{Name: F, Symbol: Sharp}},
+	}))
+	assert.Equal(t, "Gb", note.String())
+
+	// same as the notes table for the default spelling
+	note, err = NewNote(C, Sharp)
+	assert.Nil(t, err)
+	tableNote, _ := FindNote(C, Sharp)
+	assert.True(t, reflect.DeepEqual(note, tableNote))
+
+	// invalid note
+	_, err = NewNote("H", Natural)
+	assert.Error(t, err)
+}
+
+func TestNote_Equals(t *testing.T) {
+	cSharp, _ := NewNote(C, Sharp)
+	dFlat, _ := NewNote(D, Flat)
+	anotherCSharp, _ := FindNote(C, Sharp)
+
+	// Equals compares spellings, EnharmonicEquals compares how notes sound
+	assert.True(t, cSharp.Equals(anotherCSharp))
+	assert.False(t, cSharp.Equals(dFlat))
+	assert.True(t, cSharp.EnharmonicEquals(dFlat))
+	assert.True(t, cSharp.EnharmonicEquals(anotherCSharp))
+
+	d, _ := FindNote(D, Natural)
+	assert.False(t, cSharp.EnharmonicEquals(d))
+}
@@ -0,0 +1,139 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// NoteParseError describes why a note name couldn't be parsed and where the problem is.
+type NoteParseError struct {
+	Input string
+	// Position is the index (in characters, not bytes) where the problem was found
+	Position int
+	Reason   string
+}
+
+func (e *NoteParseError) Error() string {
+	return fmt.Sprintf("error parsing note '%s' at position %d: %s", e.Input, e.Position, e.Reason)
+}
+
+type accidentalToken struct {
+	token      string
+	accidental Accidental
+}
+
+// accidentalTokens maps every accepted way of writing an accidental, longest first so that "##"
+// isn't read as two separate sharps
+var accidentalTokens = []accidentalToken{
+	{"##", DoubleSharp},
+	{"♯♯", DoubleSharp},
+	{"bb", DoubleFlat},
+	{"♭♭", DoubleFlat},
+	{"𝄪", DoubleSharp},
+	{"𝄫", DoubleFlat},
+	{"x", DoubleSharp},
+	{"#", Sharp},
+	{"♯", Sharp},
+	{"b", Flat},
+	{"♭", Flat},
+	{"♮", Natural},
+}
+
+// ParseNote parses a note name such as "C#", "Db", "C♯", "D♭", "Cx", "Ebb" or "f#". An octave
+// suffix (e.g. "C#4") is accepted and validated but not kept, use ParsePitch to keep it. Names
+// written in the default naming system (e.g. "H" or "Sib") are accepted as well.
+func ParseNote(name string) (*Note, error) {
+	return defaultNamingSystem.ParseNote(name)
+}
+
+// ParseNote works like the package's ParseNote, reading names written in this naming system
+// rather than in the default one, so the result doesn't change with SetDefaultNamingSystem.
+func (s *NamingSystem) ParseNote(name string) (*Note, error) {
+	note, _, _, err := parseNoteWithOctave(name, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// parseNoteWithOctave parses a note name with an optional octave suffix, reporting whether the
+// octave was present.
+func parseNoteWithOctave(input string, system *NamingSystem) (*Note, int, bool, error) {
+	trimmed := strings.TrimSpace(input)
+
+	note, consumed, err := scanNote(trimmed, system)
+	if err != nil {
+		err.Input = input
+		return nil, 0, false, err
+	}
+
+	remaining := trimmed[consumed:]
+	if remaining == "" {
+		return note, 0, false, nil
+	}
+
+	octave, atoiErr := strconv.Atoi(remaining)
+	if atoiErr != nil {
+		position := utf8.RuneCountInString(trimmed[:consumed])
+		if _, accidentalFound := scanAccidental(remaining); accidentalFound {
+			return nil, 0, false, &NoteParseError{Input: input, Position: position, Reason: "too many accidentals, at most two are allowed"}
+		}
+		return nil, 0, false, &NoteParseError{Input: input, Position: position, Reason: fmt.Sprintf("'%s' isn't a valid octave", remaining)}
+	}
+
+	return note, octave, true, nil
+}
+
+// scanNote reads a note name from the start of the input, returning how many bytes were consumed
+// so callers can carry on parsing whatever follows it (e.g. chord symbols). Spellings of the given
+// naming system win over letter names when they are at least as long (e.g. German "B" is Bb).
+func scanNote(input string, system *NamingSystem) (*Note, int, *NoteParseError) {
+	systemNote, systemConsumed := system.scanNote(input)
+	note, consumed, err := scanLetterNote(input)
+
+	if systemConsumed > 0 && (err != nil || systemConsumed >= consumed) {
+		return systemNote, systemConsumed, nil
+	}
+
+	return note, consumed, err
+}
+
+// scanLetterNote reads a note written as a letter followed by an ASCII or Unicode accidental.
+func scanLetterNote(input string) (*Note, int, *NoteParseError) {
+	if input == "" {
+		return nil, 0, &NoteParseError{Input: input, Position: 0, Reason: "note name is empty"}
+	}
+
+	name := NaturalNote(strings.ToUpper(input[0:1]))
+	if _, letterFound := naturalNoteSemitones[name]; !letterFound {
+		return nil, 0, &NoteParseError{Input: input, Position: 0, Reason: fmt.Sprintf("'%c' isn't a note letter (A-G)", []rune(input)[0])}
+	}
+
+	consumed := 1
+	accidental := Natural
+
+	if token, accidentalFound := scanAccidental(input[consumed:]); accidentalFound {
+		accidental = token.accidental
+		consumed += len(token.token)
+	}
+
+	note, err := NewNote(name, accidental)
+	if err != nil {
+		return nil, 0, &NoteParseError{Input: input, Position: 0, Reason: err.Error()}
+	}
+
+	return note, consumed, nil
+}
+
+func scanAccidental(input string) (accidentalToken, bool) {
+	for _, token := range accidentalTokens {
+		if strings.HasPrefix(input, token.token) {
+			return token, true
+		}
+	}
+
+	return accidentalToken{}, false
+}
@@ -0,0 +1,73 @@
+package music
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_ParseNote(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"C", "C"},
+		{"C#", "C#"},
+		{"Db", "Db"},
+		{"C♯", "C#"},
+		{"D♭", "Db"},
+		{"Cx", "Cx"},
+		{"C##", "Cx"},
+		{"C𝄪", "Cx"},
+		{"Ebb", "Ebb"},
+		{"E♭♭", "Ebb"},
+		{"B𝄫", "Bbb"},
+		{"F♮", "F"},
+		{"f#", "F#"},
+		{"bb", "Bb"},
+		{"bbb", "Bbb"},
+		{" G ", "G"},
+		{"C#4", "C#"},
+		{"Eb-1", "Eb"},
+	}
+
+	for _, testCase := range testCases {
+		note, err := ParseNote(testCase.input)
+		if assert.Nil(t, err, testCase.input) {
+			assert.Equal(t, testCase.expected, note.String(), testCase.input)
+		}
+	}
+
+	// double sharp C sounds like D
+	note, _ := ParseNote("Cx")
+	d, _ := FindNote(D, Natural)
+	assert.True(t, note.EnharmonicEquals(d))
+	assert.False(t, note.Equals(d))
+}
+
+func TestParse_ParseNote_Errors(t *testing.T) {
+	testCases := []struct {
+		input    string
+		position int
+		reason   string
+	}{
+		{"", 0, "note name is empty"},
+		{"H", 0, "'H' isn't a note letter (A-G)"},
+		{"♯C", 0, "'♯' isn't a note letter (A-G)"},
+		{"C###", 3, "too many accidentals, at most two are allowed"},
+		{"D♭♭♭", 3, "too many accidentals, at most two are allowed"},
+		{"C#foo", 2, "'foo' isn't a valid octave"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := ParseNote(testCase.input)
+
+		var parseErr *NoteParseError
+		if assert.True(t, errors.As(err, &parseErr), testCase.input) {
+			assert.Equal(t, testCase.input, parseErr.Input)
+			assert.Equal(t, testCase.position, parseErr.Position, testCase.input)
+			assert.Equal(t, testCase.reason, parseErr.Reason, testCase.input)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package music
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	StandardA4Frequency float64 = 440.0
+	// MIDI note number of A4, the usual tuning reference
+	a4MIDINumber  = 69
+	minMIDINumber = 0
+	maxMIDINumber = 127
+)
+
+// Pitch is a note in a specific octave using scientific pitch notation, where C4 is middle C.
+type Pitch struct {
+	Note   Note
+	Octave int
+}
+
+func NewPitch(note *Note, octave int) *Pitch {
+	return &Pitch{
+		Note:   *note,
+		Octave: octave,
+	}
+}
+
+// ParsePitch parses pitches written in scientific pitch notation such as "E2", "C#4", "D♭4",
+// "Fx3" or "Db-1". Note names are parsed with ParseNote, so the same spellings are accepted.
+func ParsePitch(pitch string) (*Pitch, error) {
+	return defaultNamingSystem.ParsePitch(pitch)
+}
+
+// ParsePitch works like the package's ParsePitch, reading names written in this naming system
+// rather than in the default one.
+func (s *NamingSystem) ParsePitch(pitch string) (*Pitch, error) {
+	note, octave, hasOctave, err := parseNoteWithOctave(pitch, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasOctave {
+		return nil, fmt.Errorf("pitch '%s' is missing its octave, expected something like 'C#4'", pitch)
+	}
+
+	return NewPitch(note, octave), nil
+}
+
+// PitchFromMIDI returns the pitch for a MIDI note number, spelled with sharps.
+func PitchFromMIDI(midiNumber int) (*Pitch, error) {
+	if midiNumber < minMIDINumber || midiNumber > maxMIDINumber {
+		return nil, fmt.Errorf("MIDI note number '%d' has to be between %d and %d", midiNumber, minMIDINumber, maxMIDINumber)
+	}
+
+	pitchClass := PitchClass(midiNumber % PitchClassCount)
+	octave := midiNumber/PitchClassCount - 1
+
+	return NewPitch(pitchClass.Note(), octave), nil
+}
+
+func (p *Pitch) MIDI() int {
+	// spelling has already been validated when the note was created, so this can't fail for
+	// pitches built through the constructors
+	semitones, _ := semitonesFromC(p.Note.Name, p.Note.Symbol)
+	return (p.Octave+1)*PitchClassCount + semitones
+}
+
+// Frequency returns the frequency in Hz using 12-tone equal temperament, where reference is the
+// frequency of A4 (e.g. StandardA4Frequency).
+func (p *Pitch) Frequency(reference float64) float64 {
+	return reference * math.Pow(2, float64(p.MIDI()-a4MIDINumber)/float64(PitchClassCount))
+}
+
+// Equals reports whether both pitches are spelled the same way in the same octave, so B#3 and C4
+// aren't equal. Use EnharmonicEquals to compare how pitches sound.
+func (p *Pitch) Equals(anotherPitch *Pitch) bool {
+	return p.Note.Equals(&anotherPitch.Note) && p.Octave == anotherPitch.Octave
+}
+
+// EnharmonicEquals reports whether both pitches sound the same, regardless of how they are spelled.
+func (p *Pitch) EnharmonicEquals(anotherPitch *Pitch) bool {
+	return p.MIDI() == anotherPitch.MIDI()
+}
+
+func (p *Pitch) String() string {
+	return fmt.Sprintf("%s%d", p.Note.String(), p.Octave)
+}
@@ -0,0 +1,111 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPitch_ParsePitch(t *testing.T) {
+	// natural note
+	pitch, err := ParsePitch("E2")
+	assert.Nil(t, err)
+	assert.Equal(t, E, pitch.Note.Name)
+	assert.Equal(t, Natural, pitch.Note.Symbol)
+	assert.Equal(t, 2, pitch.Octave)
+
+	// spelling is preserved
+	pitch, err = ParsePitch("Db4")
+	assert.Nil(t, err)
+	assert.Equal(t, "Db4", pitch.String())
+
+	pitch, err = ParsePitch("C#4")
+	assert.Nil(t, err)
+	assert.Equal(t, "C#4", pitch.String())
+
+	// negative octave
+	pitch, err = ParsePitch("C-1")
+	assert.Nil(t, err)
+	assert.Equal(t, -1, pitch.Octave)
+
+	// unicode and double accidentals
+	pitch, err = ParsePitch("D♭4")
+	assert.Nil(t, err)
+	assert.Equal(t, "Db4", pitch.String())
+
+	pitch, err = ParsePitch("Cx4")
+	assert.Nil(t, err)
+	assert.Equal(t, 62, pitch.MIDI())
+
+	// invalid pitches
+	for _, invalid := range []string{"", "C", "H4", "C#", "C###4", "C4.5"} {
+		_, err = ParsePitch(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPitch_MIDI(t *testing.T) {
+	middleC, _ := ParsePitch("C4")
+	assert.Equal(t, 60, middleC.MIDI())
+
+	a4, _ := ParsePitch("A4")
+	assert.Equal(t, 69, a4.MIDI())
+
+	lowE, _ := ParsePitch("E2")
+	assert.Equal(t, 40, lowE.MIDI())
+
+	// spellings crossing the octave boundary belong to the octave of their letter
+	cFlat, _ := ParsePitch("Cb4")
+	assert.Equal(t, 59, cFlat.MIDI())
+
+	bSharp, _ := ParsePitch("B#3")
+	assert.Equal(t, 60, bSharp.MIDI())
+	assert.True(t, bSharp.EnharmonicEquals(middleC))
+	assert.False(t, bSharp.Equals(middleC))
+
+	otherMiddleC, _ := ParsePitch("C4")
+	assert.True(t, otherMiddleC.Equals(middleC))
+	assert.False(t, cFlat.Equals(bSharp))
+}
+
+func TestPitch_PitchFromMIDI(t *testing.T) {
+	pitch, err := PitchFromMIDI(61)
+	assert.Nil(t, err)
+	assert.Equal(t, "C#4", pitch.String())
+
+	pitch, err = PitchFromMIDI(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "C-1", pitch.String())
+
+	pitch, err = PitchFromMIDI(127)
+	assert.Nil(t, err)
+	assert.Equal(t, "G9", pitch.String())
+
+	// round trip
+	for midiNumber := range 128 {
+		pitch, _ = PitchFromMIDI(midiNumber)
+		assert.Equal(t, midiNumber, pitch.MIDI())
+	}
+
+	// out of range
+	_, err = PitchFromMIDI(-1)
+	assert.Error(t, err)
+
+	_, err = PitchFromMIDI(128)
+	assert.Error(t, err)
+}
+
+func TestPitch_Frequency(t *testing.T) {
+	a4, _ := ParsePitch("A4")
+	assert.InDelta(t, 440.0, a4.Frequency(StandardA4Frequency), 0.001)
+	assert.InDelta(t, 432.0, a4.Frequency(432), 0.001)
+
+	a3, _ := ParsePitch("A3")
+	assert.InDelta(t, 220.0, a3.Frequency(StandardA4Frequency), 0.001)
+
+	middleC, _ := ParsePitch("C4")
+	assert.InDelta(t, 261.626, middleC.Frequency(StandardA4Frequency), 0.001)
+
+	lowE, _ := ParsePitch("E2")
+	assert.InDelta(t, 82.407, lowE.Frequency(StandardA4Frequency), 0.001)
+}
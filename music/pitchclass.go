@@ -0,0 +1,79 @@
+package music
+
+import (
+	"fmt"
+)
+
+// PitchClass is the position of a note within the octave, where C is 0 and B is 11.
+// Enharmonic spellings (e.g. C# and Db) share the same pitch class.
+type PitchClass int
+
+const (
+	PitchClassCount = 12
+)
+
+var naturalNoteSemitones = map[NaturalNote]int{
+	C: 0,
+	D: 2,
+	E: 4,
+	F: 5,
+	G: 7,
+	A: 9,
+	B: 11,
+}
+
+var accidentalSemitones = map[Accidental]int{
+	Natural:     0,
+	Sharp:       1,
+	Flat:        -1,
+	DoubleSharp: 2,
+	DoubleFlat:  -2,
+}
+
+func NewPitchClass(name NaturalNote, symbol Accidental) (PitchClass, error) {
+	semitones, err := semitonesFromC(name, symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	return PitchClass(mod(semitones, PitchClassCount)), nil
+}
+
+// semitonesFromC returns the distance from the C of the same octave without wrapping it,
+// so Cb is -1 and B# is 12.
+func semitonesFromC(name NaturalNote, symbol Accidental) (int, error) {
+	letterSemitones, letterFound := naturalNoteSemitones[name]
+	accidentalOffset, accidentalFound := accidentalSemitones[symbol]
+
+	if !letterFound || !accidentalFound {
+		return 0, fmt.Errorf("note '%s%s' not found", name, symbol)
+	}
+
+	return letterSemitones + accidentalOffset, nil
+}
+
+func (p PitchClass) Transpose(semitones int) PitchClass {
+	return PitchClass(mod(int(p)+semitones, PitchClassCount))
+}
+
+// Interval returns the number of semitones (0-11) going upwards from p to another pitch class.
+func (p PitchClass) Interval(to PitchClass) int {
+	return mod(int(to)-int(p), PitchClassCount)
+}
+
+func (p PitchClass) Note() *Note {
+	note := notes[mod(int(p), PitchClassCount)]
+	return &note
+}
+
+func (p PitchClass) String() string {
+	return p.Note().String()
+}
+
+func (n *Note) PitchClass() (PitchClass, error) {
+	return NewPitchClass(n.Name, n.Symbol)
+}
+
+func mod(a int, b int) int {
+	return ((a % b) + b) % b
+}
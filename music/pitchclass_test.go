@@ -0,0 +1,85 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPitchClass_NewPitchClass(t *testing.T) {
+	// simple note
+	pitchClass, err := NewPitchClass(D, Natural)
+	assert.Nil(t, err)
+	assert.Equal(t, PitchClass(2), pitchClass)
+
+	// enharmonic spellings share the same pitch class
+	sharp, _ := NewPitchClass(C, Sharp)
+	flat, _ := NewPitchClass(D, Flat)
+	assert.Equal(t, sharp, flat)
+
+	// spellings that wrap around the octave
+	pitchClass, err = NewPitchClass(C, Flat)
+	assert.Nil(t, err)
+	assert.Equal(t, PitchClass(11), pitchClass)
+
+	pitchClass, err = NewPitchClass(B, Sharp)
+	assert.Nil(t, err)
+	assert.Equal(t, PitchClass(0), pitchClass)
+
+	// invalid note
+	_, err = NewPitchClass("H", Natural)
+	assert.Error(t, err)
+
+	_, err = NewPitchClass(C, "?")
+	assert.Error(t, err)
+}
+
+func TestPitchClass_Transpose(t *testing.T) {
+	pitchClass, _ := NewPitchClass(A, Natural)
+
+	assert.Equal(t, PitchClass(10), pitchClass.Transpose(1))
+	assert.Equal(t, PitchClass(0), pitchClass.Transpose(3))
+	assert.Equal(t, PitchClass(7), pitchClass.Transpose(-2))
+	assert.Equal(t, pitchClass, pitchClass.Transpose(24))
+	assert.Equal(t, pitchClass, pitchClass.Transpose(-36))
+}
+
+func TestPitchClass_Interval(t *testing.T) {
+	c, _ := NewPitchClass(C, Natural)
+	g, _ := NewPitchClass(G, Natural)
+
+	assert.Equal(t, 7, c.Interval(g))
+	assert.Equal(t, 5, g.Interval(c))
+	assert.Equal(t, 0, c.Interval(c))
+}
+
+func TestPitchClass_Note(t *testing.T) {
+	pitchClass, _ := NewPitchClass(E, Flat)
+	note := pitchClass.Note()
+
+	assert.Equal(t, D, note.Name)
+	assert.Equal(t, Sharp, note.Symbol)
+	assert.Equal(t, "D#", pitchClass.String())
+
+	// round trip through every pitch class
+	for i := range PitchClassCount {
+		roundTrip, err := PitchClass(i).Note().PitchClass()
+		assert.Nil(t, err)
+		assert.Equal(t, PitchClass(i), roundTrip)
+	}
+
+	// notes returned are copies, so changing them doesn't affect the notes table
+	note.Name = F
+	assert.Equal(t, D, pitchClass.Note().Name)
+}
+
+func TestPitchClass_AsMapKey(t *testing.T) {
+	fSharp, _ := FindNote(F, Sharp)
+	gFlat, _ := FindNote(G, Flat)
+
+	fSharpPitchClass, _ := fSharp.PitchClass()
+	gFlatPitchClass, _ := gFlat.PitchClass()
+
+	seen := map[PitchClass]bool{fSharpPitchClass: true}
+	assert.True(t, seen[gFlatPitchClass])
+}
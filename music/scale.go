@@ -0,0 +1,171 @@
+package music
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ScaleType struct {
+	Name      string
+	Intervals []Interval
+}
+
+var (
+	MajorScale           = newScaleType("major", "P1 M2 M3 P4 P5 M6 M7")
+	NaturalMinorScale    = newScaleType("natural minor", "P1 M2 m3 P4 P5 m6 m7")
+	HarmonicMinorScale   = newScaleType("harmonic minor", "P1 M2 m3 P4 P5 m6 M7")
+	MelodicMinorScale    = newScaleType("melodic minor", "P1 M2 m3 P4 P5 M6 M7")
+	MajorPentatonicScale = newScaleType("major pentatonic", "P1 M2 M3 P5 M6")
+	MinorPentatonicScale = newScaleType("minor pentatonic", "P1 m3 P4 P5 m7")
+	BluesScale           = newScaleType("blues", "P1 m3 P4 d5 P5 m7")
+	WholeToneScale       = newScaleType("whole tone", "P1 M2 M3 A4 A5 m7")
+	DiminishedScale      = newScaleType("diminished", "P1 M2 m3 P4 d5 m6 M6 M7")
+	HalfWholeScale       = newScaleType("half-whole diminished", "P1 m2 m3 M3 A4 P5 M6 m7")
+
+	// modes of the major scale
+	IonianMode     = newScaleType("ionian", "P1 M2 M3 P4 P5 M6 M7")
+	DorianMode     = newScaleType("dorian", "P1 M2 m3 P4 P5 M6 m7")
+	PhrygianMode   = newScaleType("phrygian", "P1 m2 m3 P4 P5 m6 m7")
+	LydianMode     = newScaleType("lydian", "P1 M2 M3 A4 P5 M6 M7")
+	MixolydianMode = newScaleType("mixolydian", "P1 M2 M3 P4 P5 M6 m7")
+	AeolianMode    = newScaleType("aeolian", "P1 M2 m3 P4 P5 m6 m7")
+	LocrianMode    = newScaleType("locrian", "P1 m2 m3 P4 d5 m6 m7")
+
+	// modes of the melodic minor scale
+	DorianFlat2Mode     = newScaleType("dorian b2", "P1 m2 m3 P4 P5 M6 m7")
+	LydianAugmentedMode = newScaleType("lydian augmented", "P1 M2 M3 A4 A5 M6 M7")
+	LydianDominantMode  = newScaleType("lydian dominant", "P1 M2 M3 A4 P5 M6 m7")
+	MixolydianFlat6Mode = newScaleType("mixolydian b6", "P1 M2 M3 P4 P5 m6 m7")
+	LocrianSharp2Mode   = newScaleType("locrian #2", "P1 M2 m3 P4 d5 m6 m7")
+	AlteredMode         = newScaleType("altered", "P1 m2 m3 d4 d5 m6 m7")
+
+	// modes of the harmonic minor scale
+	LocrianSharp6Mode           = newScaleType("locrian #6", "P1 m2 m3 P4 d5 M6 m7")
+	IonianSharp5Mode            = newScaleType("ionian #5", "P1 M2 M3 P4 A5 M6 M7")
+	DorianSharp4Mode            = newScaleType("dorian #4", "P1 M2 m3 A4 P5 M6 m7")
+	PhrygianDominantMode        = newScaleType("phrygian dominant", "P1 m2 M3 P4 P5 m6 m7")
+	LydianSharp2Mode            = newScaleType("lydian #2", "P1 A2 M3 A4 P5 M6 M7")
+	SuperLocrianDoubleFlat7Mode = newScaleType("super locrian bb7", "P1 m2 m3 d4 d5 m6 d7")
+)
+
+var scaleTypes = []ScaleType{
+	MajorScale,
+	NaturalMinorScale,
+	HarmonicMinorScale,
+	MelodicMinorScale,
+	MajorPentatonicScale,
+	MinorPentatonicScale,
+	BluesScale,
+	WholeToneScale,
+	DiminishedScale,
+	HalfWholeScale,
+	IonianMode,
+	DorianMode,
+	PhrygianMode,
+	LydianMode,
+	MixolydianMode,
+	AeolianMode,
+	LocrianMode,
+	DorianFlat2Mode,
+	LydianAugmentedMode,
+	LydianDominantMode,
+	MixolydianFlat6Mode,
+	LocrianSharp2Mode,
+	AlteredMode,
+	LocrianSharp6Mode,
+	IonianSharp5Mode,
+	DorianSharp4Mode,
+	PhrygianDominantMode,
+	LydianSharp2Mode,
+	SuperLocrianDoubleFlat7Mode,
+}
+
+// newScaleType builds the scale catalogue from interval formulas. Formulas are hard-coded, so
+// an invalid one is a programming error.
+func newScaleType(name string, formula string) ScaleType {
+	intervals := make([]Interval, 0)
+
+	for _, token := range strings.Fields(formula) {
+		interval, err := ParseInterval(token)
+		if err != nil {
+			panic(fmt.Sprintf("invalid formula for scale '%s': %v", name, err))
+		}
+		intervals = append(intervals, interval)
+	}
+
+	return ScaleType{
+		Name:      name,
+		Intervals: intervals,
+	}
+}
+
+func ScaleTypes() []ScaleType {
+	return append([]ScaleType{}, scaleTypes...)
+}
+
+// FindScaleType looks up a scale from the catalogue by name, ignoring case (e.g. "Mixolydian").
+func FindScaleType(name string) (ScaleType, error) {
+	normalizedName := strings.ToLower(strings.Join(strings.Fields(name), " "))
+
+	for _, scaleType := range scaleTypes {
+		if scaleType.Name == normalizedName {
+			return scaleType, nil
+		}
+	}
+
+	return ScaleType{}, fmt.Errorf("scale '%s' not found", name)
+}
+
+type Scale struct {
+	Root  Note
+	Type  ScaleType
+	Notes []*Note
+}
+
+func NewScale(root *Note, scaleType ScaleType) (*Scale, error) {
+	scaleNotes := make([]*Note, len(scaleType.Intervals))
+
+	for i, interval := range scaleType.Intervals {
+		note, err := root.Add(interval)
+		if err != nil {
+			return nil, fmt.Errorf("error building %s %s scale: %v", root.String(), scaleType.Name, err)
+		}
+		scaleNotes[i] = note
+	}
+
+	return &Scale{
+		Root:  *root,
+		Type:  scaleType,
+		Notes: scaleNotes,
+	}, nil
+}
+
+// Degree returns the note of a 1-indexed scale degree, wrapping around the octave so the 9th
+// degree of a heptatonic scale is its 2nd.
+func (s *Scale) Degree(degree int) (*Note, error) {
+	if degree < 1 {
+		return nil, fmt.Errorf("scale degrees are 1-indexed, got '%d'", degree)
+	}
+
+	return s.Notes[(degree-1)%len(s.Notes)], nil
+}
+
+// Contains reports whether the scale has a note that sounds like the given one.
+func (s *Scale) Contains(note *Note) bool {
+	for _, scaleNote := range s.Notes {
+		if scaleNote.EnharmonicEquals(note) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Scale) String() string {
+	noteNames := make([]string, len(s.Notes))
+	for i, note := range s.Notes {
+		noteNames[i] = note.String()
+	}
+
+	return fmt.Sprintf("%s %s [ %s ]", s.Root.String(), s.Type.Name, strings.Join(noteNames, " "))
+}
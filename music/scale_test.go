@@ -0,0 +1,138 @@
+package music
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScale_NewScale(t *testing.T) {
+	testCases := []struct {
+		root      string
+		scaleType ScaleType
+		expected  []string
+	}{
+		{"C", MajorScale, []string{"C", "D", "E", "F", "G", "A", "B"}},
+		{"C", MixolydianMode, []string{"C", "D", "E", "F", "G", "A", "Bb"}},
+		{"F", MajorScale, []string{"F", "G", "A", "Bb", "C", "D", "E"}},
+		{"G#", MajorScale, []string{"G#", "A#", "B#", "C#", "D#", "E#", "Fx"}},
+		{"Eb", NaturalMinorScale, []string{"Eb", "F", "Gb", "Ab", "Bb", "Cb", "Db"}},
+		{"F#", HarmonicMinorScale, []string{"F#", "G#", "A", "B", "C#", "D", "E#"}},
+		{"A", MinorPentatonicScale, []string{"A", "C", "D", "E", "G"}},
+		{"A", BluesScale, []string{"A", "C", "D", "Eb", "E", "G"}},
+		{"C", WholeToneScale, []string{"C", "D", "E", "F#", "G#", "Bb"}},
+		{"C", DiminishedScale, []string{"C", "D", "Eb", "F", "Gb", "Ab", "A", "B"}},
+		{"G", AlteredMode, []string{"G", "Ab", "Bb", "Cb", "Db", "Eb", "F"}},
+		{"E", PhrygianDominantMode, []string{"E", "F", "G#", "A", "B", "C", "D"}},
+	}
+
+	for _, testCase := range testCases {
+		root := mustParseTestNote(t, testCase.root)
+		scale, err := NewScale(root, testCase.scaleType)
+		assert.Nil(t, err)
+
+		noteNames := make([]string, 0)
+		for _, note := range scale.Notes {
+			noteNames = append(noteNames, note.String())
+		}
+		assert.Equal(t, testCase.expected, noteNames, "%s %s", testCase.root, testCase.scaleType.Name)
+	}
+
+	// would need triple flats
+	root := mustParseTestNote(t, "Fb")
+	_, err := NewScale(root, SuperLocrianDoubleFlat7Mode)
+	assert.Error(t, err)
+}
+
+func TestScale_HeptatonicScalesUseEveryLetterOnce(t *testing.T) {
+	roots := []string{"C", "C#", "Db", "D", "Eb", "E", "F", "F#", "Gb", "G", "Ab", "A", "Bb", "B", "Cb"}
+
+	for _, scaleType := range ScaleTypes() {
+		if len(scaleType.Intervals) != 7 {
+			continue
+		}
+
+		for _, rootName := range roots {
+			scale, err := NewScale(mustParseTestNote(t, rootName), scaleType)
+			if err != nil {
+				// only exotic modes on exotic roots need more than two accidentals
+				continue
+			}
+
+			letters := make([]NaturalNote, 0)
+			for _, note := range scale.Notes {
+				assert.NotContains(t, letters, note.Name, scale.String())
+				letters = append(letters, note.Name)
+			}
+		}
+	}
+}
+
+func TestScale_ModesAreRotationsOfTheirParentScale(t *testing.T) {
+	families := map[*ScaleType][]ScaleType{
+		&MajorScale:         {IonianMode, DorianMode, PhrygianMode, LydianMode, MixolydianMode, AeolianMode, LocrianMode},
+		&MelodicMinorScale:  {MelodicMinorScale, DorianFlat2Mode, LydianAugmentedMode, LydianDominantMode, MixolydianFlat6Mode, LocrianSharp2Mode, AlteredMode},
+		&HarmonicMinorScale: {HarmonicMinorScale, LocrianSharp6Mode, IonianSharp5Mode, DorianSharp4Mode, PhrygianDominantMode, LydianSharp2Mode, SuperLocrianDoubleFlat7Mode},
+	}
+
+	for parent, modes := range families {
+		for modeIndex, mode := range modes {
+			rotated := make([]int, 0)
+			start := parent.Intervals[modeIndex].Semitones()
+			for i := range parent.Intervals {
+				interval := parent.Intervals[(modeIndex+i)%len(parent.Intervals)]
+				rotated = append(rotated, mod(interval.Semitones()-start, PitchClassCount))
+			}
+
+			modeSemitones := make([]int, 0)
+			for _, interval := range mode.Intervals {
+				modeSemitones = append(modeSemitones, interval.Semitones())
+			}
+
+			assert.Equal(t, rotated, modeSemitones, mode.Name)
+		}
+	}
+
+	// the natural minor is the aeolian mode
+	assert.Equal(t, AeolianMode.Intervals, NaturalMinorScale.Intervals)
+}
+
+func TestScale_FindScaleType(t *testing.T) {
+	scaleType, err := FindScaleType("Mixolydian")
+	assert.Nil(t, err)
+	assert.Equal(t, MixolydianMode.Name, scaleType.Name)
+
+	scaleType, err = FindScaleType("  harmonic   minor ")
+	assert.Nil(t, err)
+	assert.Equal(t, HarmonicMinorScale.Name, scaleType.Name)
+
+	_, err = FindScaleType("bebop")
+	assert.Error(t, err)
+
+	// changing the returned catalogue doesn't affect the package one
+	catalogue := ScaleTypes()
+	catalogue[0] = BluesScale
+	assert.Equal(t, MajorScale.Name, ScaleTypes()[0].Name)
+}
+
+func TestScale_DegreeAndContains(t *testing.T) {
+	scale, _ := NewScale(mustParseTestNote(t, "D"), MajorScale)
+
+	note, err := scale.Degree(3)
+	assert.Nil(t, err)
+	assert.Equal(t, "F#", note.String())
+
+	note, err = scale.Degree(9)
+	assert.Nil(t, err)
+	assert.Equal(t, "E", note.String())
+
+	_, err = scale.Degree(0)
+	assert.Error(t, err)
+
+	assert.True(t, scale.Contains(mustParseTestNote(t, "Gb")))
+	assert.False(t, scale.Contains(mustParseTestNote(t, "F")))
+
+	assert.True(t, slices.ContainsFunc(scale.Notes, func(n *Note) bool { return n.String() == "C#" }))
+	assert.Equal(t, "D major [ D E F# G A B C# ]", scale.String())
+}
@@ -0,0 +1,236 @@
+package sets
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+)
+
+// PitchClassSet is a sorted collection of unique pitch classes, where C is 0.
+type PitchClassSet []music.PitchClass
+
+// IntervalVector counts how many times each interval class (1 to 6) shows up between every pair
+// of pitch classes in a set.
+type IntervalVector [6]int
+
+func NewPitchClassSet(pitchClasses ...music.PitchClass) PitchClassSet {
+	set := make(PitchClassSet, 0, len(pitchClasses))
+	for _, pitchClass := range pitchClasses {
+		pitchClass = pitchClass.Transpose(0)
+		if !slices.Contains(set, pitchClass) {
+			set = append(set, pitchClass)
+		}
+	}
+
+	slices.Sort(set)
+	return set
+}
+
+func FromNotes(notes []*music.Note) (PitchClassSet, error) {
+	pitchClasses := make([]music.PitchClass, 0, len(notes))
+	for _, note := range notes {
+		pitchClass, err := note.PitchClass()
+		if err != nil {
+			return nil, err
+		}
+		pitchClasses = append(pitchClasses, pitchClass)
+	}
+
+	return NewPitchClassSet(pitchClasses...), nil
+}
+
+func (s PitchClassSet) Notes() []*music.Note {
+	notes := make([]*music.Note, len(s))
+	for i, pitchClass := range s {
+		notes[i] = pitchClass.Note()
+	}
+
+	return notes
+}
+
+func (s PitchClassSet) Cardinality() int {
+	return len(s)
+}
+
+func (s PitchClassSet) Contains(pitchClass music.PitchClass) bool {
+	return slices.Contains(s, pitchClass.Transpose(0))
+}
+
+// Transpose applies Tn to every pitch class of the set.
+func (s PitchClassSet) Transpose(semitones int) PitchClassSet {
+	transposed := make([]music.PitchClass, len(s))
+	for i, pitchClass := range s {
+		transposed[i] = pitchClass.Transpose(semitones)
+	}
+
+	return NewPitchClassSet(transposed...)
+}
+
+// Invert applies I (i.e. T0I), mirroring every pitch class around C.
+func (s PitchClassSet) Invert() PitchClassSet {
+	inverted := make([]music.PitchClass, len(s))
+	for i, pitchClass := range s {
+		inverted[i] = music.PitchClass(0).Transpose(-int(pitchClass))
+	}
+
+	return NewPitchClassSet(inverted...)
+}
+
+func (s PitchClassSet) Complement() PitchClassSet {
+	complement := make([]music.PitchClass, 0, music.PitchClassCount-len(s))
+	for pitchClass := range music.PitchClass(music.PitchClassCount) {
+		if !s.Contains(pitchClass) {
+			complement = append(complement, pitchClass)
+		}
+	}
+
+	return NewPitchClassSet(complement...)
+}
+
+func (s PitchClassSet) Equals(anotherSet PitchClassSet) bool {
+	return slices.Equal(NewPitchClassSet(s...), NewPitchClassSet(anotherSet...))
+}
+
+// NormalForm returns the rotation of the set spanning the smallest interval. Ties are broken
+// Rahn's way, by comparing the intervals from the first pitch class to the last, then to the
+// next to last and so on, and then by the lowest starting pitch class.
+func (s PitchClassSet) NormalForm() PitchClassSet {
+	if len(s) == 0 {
+		return PitchClassSet{}
+	}
+
+	var normalForm PitchClassSet
+	var normalFormIntervals []int
+
+	for start := range s {
+		rotation := append(slices.Clone(s[start:]), s[:start]...)
+		intervals := intervalsFromFirst(rotation)
+
+		if normalForm == nil || compareFromRight(intervals, normalFormIntervals) < 0 {
+			normalForm = rotation
+			normalFormIntervals = intervals
+		}
+	}
+
+	return normalForm
+}
+
+// PrimeForm returns the most packed form of the set or its inversion, transposed to start on 0.
+func (s PitchClassSet) PrimeForm() PitchClassSet {
+	if len(s) == 0 {
+		return PitchClassSet{}
+	}
+
+	primeForm := s.NormalForm().zeroed()
+	if inverted := s.Invert().NormalForm().zeroed(); compareFromRight(toInts(inverted), toInts(primeForm)) < 0 {
+		primeForm = inverted
+	}
+
+	return primeForm
+}
+
+func (s PitchClassSet) IntervalVector() IntervalVector {
+	var vector IntervalVector
+	for i := range s {
+		for j := i + 1; j < len(s); j++ {
+			interval := s[i].Interval(s[j])
+			intervalClass := min(interval, music.PitchClassCount-interval)
+			vector[intervalClass-1]++
+		}
+	}
+
+	return vector
+}
+
+// IsTranspositionOf reports whether the set is Tn of another set, returning n.
+func (s PitchClassSet) IsTranspositionOf(anotherSet PitchClassSet) (int, bool) {
+	for semitones := range music.PitchClassCount {
+		if anotherSet.Transpose(semitones).Equals(s) {
+			return semitones, true
+		}
+	}
+
+	return 0, false
+}
+
+// IsInversionOf reports whether the set is TnI of another set, returning n.
+func (s PitchClassSet) IsInversionOf(anotherSet PitchClassSet) (int, bool) {
+	return s.IsTranspositionOf(anotherSet.Invert())
+}
+
+// Equivalent reports whether both sets belong to the same set class, i.e. they are related by
+// transposition or inversion.
+func (s PitchClassSet) Equivalent(anotherSet PitchClassSet) bool {
+	return s.PrimeForm().Equals(anotherSet.PrimeForm())
+}
+
+func (s PitchClassSet) ForteNumber() (string, error) {
+	forteNumber, found := forteNumbersByPrimeForm[s.PrimeForm().String()]
+	if !found {
+		return "", fmt.Errorf("set %s doesn't have a Forte number, only sets with 3 to 9 pitch classes do", s.String())
+	}
+
+	return forteNumber, nil
+}
+
+func (s PitchClassSet) String() string {
+	numbers := make([]string, len(s))
+	for i, pitchClass := range s {
+		numbers[i] = strconv.Itoa(int(pitchClass))
+	}
+
+	return "[" + strings.Join(numbers, ",") + "]"
+}
+
+func (v IntervalVector) String() string {
+	var sb strings.Builder
+	sb.WriteString("<")
+	for _, count := range v {
+		sb.WriteString(strconv.Itoa(count))
+	}
+	sb.WriteString(">")
+
+	return sb.String()
+}
+
+// zeroed transposes a set in normal form so that it starts on 0, keeping its order.
+func (s PitchClassSet) zeroed() PitchClassSet {
+	zeroed := make(PitchClassSet, len(s))
+	for i, pitchClass := range s {
+		zeroed[i] = music.PitchClass(s[0].Interval(pitchClass))
+	}
+
+	return zeroed
+}
+
+func intervalsFromFirst(pitchClasses PitchClassSet) []int {
+	intervals := make([]int, len(pitchClasses))
+	for i, pitchClass := range pitchClasses {
+		intervals[i] = pitchClasses[0].Interval(pitchClass)
+	}
+
+	return intervals
+}
+
+// compareFromRight compares two lists of the same length starting from their last element.
+func compareFromRight(a []int, b []int) int {
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+
+	return 0
+}
+
+func toInts(set PitchClassSet) []int {
+	ints := make([]int, len(set))
+	for i, pitchClass := range set {
+		ints[i] = int(pitchClass)
+	}
+
+	return ints
+}
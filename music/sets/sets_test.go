@@ -0,0 +1,208 @@
+package sets
+
+import (
+	"testing"
+
+	"github.com/PauloMigAlmeida/fretboard-games/music"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustSetFromNames(t *testing.T, names ...string) PitchClassSet {
+	notes := make([]*music.Note, len(names))
+	for i, name := range names {
+		note, err := music.ParseNote(name)
+		assert.Nil(t, err)
+		notes[i] = note
+	}
+
+	set, err := FromNotes(notes)
+	assert.Nil(t, err)
+	return set
+}
+
+func TestPitchClassSet_NewPitchClassSet(t *testing.T) {
+	set := NewPitchClassSet(7, 0, 4, 12, 16)
+	assert.Equal(t, "[0,4,7]", set.String())
+	assert.Equal(t, 3, set.Cardinality())
+
+	// enharmonic spellings collapse into the same pitch class
+	set = mustSetFromNames(t, "C#", "Db", "F", "Ab")
+	assert.Equal(t, "[1,5,8]", set.String())
+	assert.Equal(t, "G#", set.Notes()[2].String())
+}
+
+func TestPitchClassSet_NormalForm(t *testing.T) {
+	testCases := []struct {
+		set      PitchClassSet
+		expected string
+	}{
+		// C major triad
+		{NewPitchClassSet(0, 4, 7), "[0,4,7]"},
+		// first inversion wraps around the octave
+		{NewPitchClassSet(4, 7, 0), "[0,4,7]"},
+		// B diminished seventh, all rotations have the same span so the lowest start wins
+		{NewPitchClassSet(11, 2, 5, 8), "[2,5,8,11]"},
+		{NewPitchClassSet(10, 11, 2), "[10,11,2]"},
+		// ties broken from the right: [0,1,5,6,8] and [5,6,8,0,1] both span 8
+		{NewPitchClassSet(0, 1, 5, 6, 8), "[0,1,5,6,8]"},
+		{NewPitchClassSet(), "[]"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, testCase.set.NormalForm().String())
+	}
+}
+
+func TestPitchClassSet_PrimeForm(t *testing.T) {
+	testCases := []struct {
+		set      PitchClassSet
+		expected string
+	}{
+		// major and minor triads are both 3-11
+		{mustSetFromNames(t, "C", "E", "G"), "[0,3,7]"},
+		{mustSetFromNames(t, "A", "C", "E"), "[0,3,7]"},
+		// dominant seventh
+		{mustSetFromNames(t, "G", "B", "D", "F"), "[0,2,5,8]"},
+		// major scale
+		{mustSetFromNames(t, "C", "D", "E", "F", "G", "A", "B"), "[0,1,3,5,6,8,10]"},
+		// Rahn's prime form of 5-20, Forte packs it to the left as [0,1,3,7,8] instead
+		{NewPitchClassSet(0, 1, 3, 7, 8), "[0,1,5,6,8]"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, testCase.set.PrimeForm().String())
+	}
+}
+
+func TestPitchClassSet_IntervalVector(t *testing.T) {
+	assert.Equal(t, IntervalVector{0, 0, 1, 1, 1, 0}, NewPitchClassSet(0, 4, 7).IntervalVector())
+	assert.Equal(t, "<254361>", mustSetFromNames(t, "C", "D", "E", "F", "G", "A", "B").IntervalVector().String())
+	assert.Equal(t, "<000300>", NewPitchClassSet(0, 4, 8).IntervalVector().String())
+
+	// all-interval tetrachords share the same vector
+	z15, _ := FindForteNumber("4-Z15")
+	z29, _ := FindForteNumber("4-Z29")
+	assert.Equal(t, "<111111>", z15.IntervalVector().String())
+	assert.Equal(t, z15.IntervalVector(), z29.IntervalVector())
+	assert.False(t, z15.Equivalent(z29))
+}
+
+func TestPitchClassSet_ForteNumber(t *testing.T) {
+	testCases := []struct {
+		set      PitchClassSet
+		expected string
+	}{
+		{mustSetFromNames(t, "C", "E", "G"), "3-11"},
+		{mustSetFromNames(t, "C", "E", "G#"), "3-12"},
+		{mustSetFromNames(t, "G", "B", "D", "F"), "4-27"},
+		{mustSetFromNames(t, "C", "Eb", "Gb", "A"), "4-28"},
+		{mustSetFromNames(t, "C", "D", "E", "G", "A"), "5-35"},
+		{NewPitchClassSet(0, 1, 5, 6, 8), "5-20"},
+		{NewPitchClassSet(0, 2, 4, 6, 8, 10), "6-35"},
+		{NewPitchClassSet(0, 1, 3, 6, 8, 9), "6-Z29"},
+		{mustSetFromNames(t, "C", "D", "E", "F", "G", "A", "B"), "7-35"},
+		{NewPitchClassSet(0, 1, 3, 4, 6, 7, 9, 10), "8-28"},
+		{NewPitchClassSet(0, 1, 2, 3, 4, 5, 6, 7, 8), "9-1"},
+	}
+
+	for _, testCase := range testCases {
+		forteNumber, err := testCase.set.ForteNumber()
+		assert.Nil(t, err, testCase.expected)
+		assert.Equal(t, testCase.expected, forteNumber)
+	}
+
+	_, err := NewPitchClassSet(0, 7).ForteNumber()
+	assert.Error(t, err)
+
+	_, err = NewPitchClassSet(0, 1, 2, 3, 4, 5, 6, 7, 8, 9).ForteNumber()
+	assert.Error(t, err)
+}
+
+func TestPitchClassSet_ForteCatalogue(t *testing.T) {
+	// every set with 3 to 9 pitch classes has a Forte number, and there are as many set classes
+	// as Forte listed
+	expectedSetClasses := map[int]int{3: 12, 4: 29, 5: 38, 6: 50, 7: 38, 8: 29, 9: 12}
+	setClasses := map[int]map[string]bool{}
+
+	for bits := range 1 << music.PitchClassCount {
+		pitchClasses := make([]music.PitchClass, 0)
+		for pitchClass := range music.PitchClassCount {
+			if bits&(1<<pitchClass) != 0 {
+				pitchClasses = append(pitchClasses, music.PitchClass(pitchClass))
+			}
+		}
+
+		set := NewPitchClassSet(pitchClasses...)
+		if _, listed := expectedSetClasses[set.Cardinality()]; !listed {
+			continue
+		}
+
+		forteNumber, err := set.ForteNumber()
+		if !assert.Nil(t, err, set.String()) {
+			return
+		}
+
+		if setClasses[set.Cardinality()] == nil {
+			setClasses[set.Cardinality()] = map[string]bool{}
+		}
+		setClasses[set.Cardinality()][forteNumber] = true
+	}
+
+	for cardinality, count := range expectedSetClasses {
+		assert.Len(t, setClasses[cardinality], count, "cardinality %d", cardinality)
+	}
+
+	// complements share the ordinal of their Forte number
+	fiveTwenty, _ := FindForteNumber("5-20")
+	forteNumber, _ := fiveTwenty.Complement().ForteNumber()
+	assert.Equal(t, "7-20", forteNumber)
+}
+
+func TestPitchClassSet_FindForteNumber(t *testing.T) {
+	set, err := FindForteNumber("3-11")
+	assert.Nil(t, err)
+	assert.Equal(t, "[0,3,7]", set.String())
+
+	set, err = FindForteNumber("6-z29")
+	assert.Nil(t, err)
+	assert.Equal(t, 6, set.Cardinality())
+
+	// Z can be left out
+	set, err = FindForteNumber("4-15")
+	assert.Nil(t, err)
+	assert.Equal(t, "[0,1,4,6]", set.String())
+
+	_, err = FindForteNumber("3-13")
+	assert.Error(t, err)
+}
+
+func TestPitchClassSet_TranspositionAndInversion(t *testing.T) {
+	cMajor := mustSetFromNames(t, "C", "E", "G")
+	dMajor := mustSetFromNames(t, "D", "F#", "A")
+	cMinor := mustSetFromNames(t, "C", "Eb", "G")
+
+	semitones, found := dMajor.IsTranspositionOf(cMajor)
+	assert.True(t, found)
+	assert.Equal(t, 2, semitones)
+
+	_, found = cMinor.IsTranspositionOf(cMajor)
+	assert.False(t, found)
+
+	// C minor is T7I of C major: 0,4,7 -> 0,8,5 -> 7,3,0
+	semitones, found = cMinor.IsInversionOf(cMajor)
+	assert.True(t, found)
+	assert.Equal(t, 7, semitones)
+	assert.True(t, cMinor.Equivalent(cMajor))
+
+	assert.Equal(t, "[0,5,8]", cMajor.Invert().String())
+	assert.Equal(t, "[2,6,9]", cMajor.Transpose(14).String())
+}
+
+func TestPitchClassSet_Complement(t *testing.T) {
+	pentatonic := mustSetFromNames(t, "C#", "D#", "F#", "G#", "A#")
+	complement := pentatonic.Complement()
+
+	assert.Equal(t, "[0,2,4,5,7,9,11]", complement.String())
+	assert.True(t, complement.Complement().Equals(pentatonic))
+	assert.Len(t, NewPitchClassSet().Complement(), music.PitchClassCount)
+}
@@ -0,0 +1,191 @@
+package music
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	centsPerOctave   = 1200.0
+	centsPerSemitone = centsPerOctave / PitchClassCount
+)
+
+// Temperament decides how far each note is from the tonic. Apart from equal temperament, notes
+// that sound the same in 12-TET (e.g. G# and Ab) may be tuned differently, so notes are used
+// rather than pitch classes.
+type Temperament struct {
+	Name string
+	// centsAbove returns how many cents a note is above the tonic, between 0 and 1200
+	centsAbove func(tonic *Note, note *Note) (float64, error)
+}
+
+var EqualTemperament = &Temperament{
+	Name: "equal",
+	centsAbove: func(tonic *Note, note *Note) (float64, error) {
+		semitones, err := semitonesAbove(tonic, note)
+		if err != nil {
+			return 0, err
+		}
+
+		return float64(semitones) * centsPerSemitone, nil
+	},
+}
+
+// PythagoreanTuning stacks pure 3:2 fifths from the tonic.
+var PythagoreanTuning = &Temperament{
+	Name:       "pythagorean",
+	centsAbove: stackedFifths(ratioToCents(3.0 / 2.0)),
+}
+
+// QuarterCommaMeantone narrows every fifth by a quarter of the syntonic comma (81:80), which makes
+// major thirds pure.
+var QuarterCommaMeantone = &Temperament{
+	Name:       "meantone",
+	centsAbove: stackedFifths(ratioToCents(3.0/2.0) - ratioToCents(81.0/80.0)/4),
+}
+
+// JustIntonation tunes every interval from the tonic to a 5-limit ratio.
+var JustIntonation = &Temperament{
+	Name: "just",
+	centsAbove: func(tonic *Note, note *Note) (float64, error) {
+		semitones, err := semitonesAbove(tonic, note)
+		if err != nil {
+			return 0, err
+		}
+
+		// augmented and diminished intervals have their own ratios (e.g. A4 is 45:32 and d5 is 64:45)
+		if interval, err := tonic.IntervalTo(note); err == nil {
+			if ratio, found := justIntervalRatios[interval]; found {
+				return ratioToCents(ratio), nil
+			}
+		}
+
+		return ratioToCents(justSemitoneRatios[semitones]), nil
+	},
+}
+
+var temperaments = []*Temperament{EqualTemperament, PythagoreanTuning, QuarterCommaMeantone, JustIntonation}
+
+var justIntervalRatios = map[Interval]float64{
+	PerfectUnison:     1.0,
+	MinorSecond:       16.0 / 15.0,
+	MajorSecond:       9.0 / 8.0,
+	AugmentedSecond:   75.0 / 64.0,
+	MinorThird:        6.0 / 5.0,
+	MajorThird:        5.0 / 4.0,
+	PerfectFourth:     4.0 / 3.0,
+	AugmentedFourth:   45.0 / 32.0,
+	DiminishedFifth:   64.0 / 45.0,
+	PerfectFifth:      3.0 / 2.0,
+	AugmentedFifth:    25.0 / 16.0,
+	MinorSixth:        8.0 / 5.0,
+	MajorSixth:        5.0 / 3.0,
+	DiminishedSeventh: 128.0 / 75.0,
+	MinorSeventh:      16.0 / 9.0,
+	MajorSeventh:      15.0 / 8.0,
+}
+
+// ratios used for intervals without one of their own, by number of semitones above the tonic
+var justSemitoneRatios = [PitchClassCount]float64{
+	1.0, 16.0 / 15.0, 9.0 / 8.0, 6.0 / 5.0, 5.0 / 4.0, 4.0 / 3.0,
+	45.0 / 32.0, 3.0 / 2.0, 8.0 / 5.0, 5.0 / 3.0, 16.0 / 9.0, 15.0 / 8.0,
+}
+
+func Temperaments() []*Temperament {
+	return append([]*Temperament{}, temperaments...)
+}
+
+func FindTemperament(name string) (*Temperament, error) {
+	normalizedName := strings.ToLower(strings.TrimSpace(name))
+	for _, temperament := range temperaments {
+		if temperament.Name == normalizedName {
+			return temperament, nil
+		}
+	}
+
+	return nil, fmt.Errorf("temperament '%s' doesn't exist", name)
+}
+
+// Cents returns how many cents the note is above the tonic, between 0 and 1200.
+func (t *Temperament) Cents(tonic *Note, note *Note) (float64, error) {
+	return t.centsAbove(tonic, note)
+}
+
+// Deviation returns how many cents the note is above (positive) or below (negative) the same
+// note in equal temperament when both are tuned from the tonic.
+func (t *Temperament) Deviation(tonic *Note, note *Note) (float64, error) {
+	cents, err := t.centsAbove(tonic, note)
+	if err != nil {
+		return 0, err
+	}
+
+	equalCents, err := EqualTemperament.centsAbove(tonic, note)
+	if err != nil {
+		return 0, err
+	}
+
+	// keep B# a few cents above C rather than almost an octave above it
+	deviation := math.Mod(cents-equalCents+centsPerOctave/2, centsPerOctave)
+	if deviation < 0 {
+		deviation += centsPerOctave
+	}
+
+	return deviation - centsPerOctave/2, nil
+}
+
+// Frequency returns the frequency in Hz of the pitch in this temperament. The tonic keeps its
+// equal temperament frequency, with reference being the frequency of A4 (e.g. StandardA4Frequency).
+func (t *Temperament) Frequency(pitch *Pitch, tonic *Note, reference float64) (float64, error) {
+	deviation, err := t.Deviation(tonic, &pitch.Note)
+	if err != nil {
+		return 0, err
+	}
+
+	return pitch.Frequency(reference) * math.Pow(2, deviation/centsPerOctave), nil
+}
+
+func (t *Temperament) String() string {
+	return t.Name
+}
+
+// stackedFifths tunes notes by walking the line of fifths from the tonic, using fifths of the
+// given size in cents.
+func stackedFifths(fifthCents float64) func(tonic *Note, note *Note) (float64, error) {
+	return func(tonic *Note, note *Note) (float64, error) {
+		if _, err := semitonesAbove(tonic, note); err != nil {
+			return 0, err
+		}
+
+		fifths := lineOfFifths(note) - lineOfFifths(tonic)
+		cents := math.Mod(float64(fifths)*fifthCents, centsPerOctave)
+		if cents < 0 {
+			cents += centsPerOctave
+		}
+
+		return cents, nil
+	}
+}
+
+func semitonesAbove(tonic *Note, note *Note) (int, error) {
+	tonicPitchClass, err := tonic.PitchClass()
+	if err != nil {
+		return 0, err
+	}
+
+	pitchClass, err := note.PitchClass()
+	if err != nil {
+		return 0, err
+	}
+
+	return tonicPitchClass.Interval(pitchClass), nil
+}
+
+// lineOfFifths returns the position of a note on the line of fifths, relative to C.
+func lineOfFifths(note *Note) int {
+	return naturalNoteFifths[note.Name] + accidentalFifths[note.Symbol]
+}
+
+func ratioToCents(ratio float64) float64 {
+	return centsPerOctave * math.Log2(ratio)
+}
@@ -0,0 +1,99 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemperament_Deviation(t *testing.T) {
+	testCases := []struct {
+		temperament *Temperament
+		note        string
+		expected    float64
+	}{
+		{EqualTemperament, "E", 0},
+		{EqualTemperament, "Ab", 0},
+		{PythagoreanTuning, "C", 0},
+		{PythagoreanTuning, "G", 1.955},
+		{PythagoreanTuning, "F", -1.955},
+		{PythagoreanTuning, "E", 7.820},
+		// enharmonic notes are tuned differently
+		{PythagoreanTuning, "G#", 15.641},
+		{PythagoreanTuning, "Ab", -7.820},
+		// the Pythagorean comma
+		{PythagoreanTuning, "B#", 23.460},
+		{PythagoreanTuning, "Cb", -13.685},
+		// pure major thirds
+		{QuarterCommaMeantone, "E", -13.686},
+		{QuarterCommaMeantone, "G", -3.422},
+		{JustIntonation, "E", -13.686},
+		{JustIntonation, "G", 1.955},
+		{JustIntonation, "A", -15.641},
+		{JustIntonation, "Bb", -3.910},
+		{JustIntonation, "F#", -9.776},
+		{JustIntonation, "Gb", 9.776},
+	}
+
+	c, _ := ParseNote("C")
+	for _, testCase := range testCases {
+		note, _ := ParseNote(testCase.note)
+		deviation, err := testCase.temperament.Deviation(c, note)
+		assert.Nil(t, err)
+		assert.InDelta(t, testCase.expected, deviation, 0.001, "%s %s", testCase.temperament.Name, testCase.note)
+	}
+
+	// deviations are relative to the tonic
+	d, _ := ParseNote("D")
+	fSharp, _ := ParseNote("F#")
+	deviation, _ := JustIntonation.Deviation(d, fSharp)
+	assert.InDelta(t, -13.686, deviation, 0.001)
+}
+
+func TestTemperament_Cents(t *testing.T) {
+	c, _ := ParseNote("C")
+	g, _ := ParseNote("G")
+	b, _ := ParseNote("B")
+
+	cents, err := PythagoreanTuning.Cents(c, g)
+	assert.Nil(t, err)
+	assert.InDelta(t, 701.955, cents, 0.001)
+
+	cents, _ = EqualTemperament.Cents(c, b)
+	assert.InDelta(t, 1100, cents, 0.001)
+
+	cents, _ = JustIntonation.Cents(g, c)
+	assert.InDelta(t, 498.045, cents, 0.001)
+}
+
+func TestTemperament_Frequency(t *testing.T) {
+	c, _ := ParseNote("C")
+	a4, _ := ParsePitch("A4")
+	c4, _ := ParsePitch("C4")
+
+	// the tonic keeps its equal temperament frequency
+	frequency, err := JustIntonation.Frequency(c4, c, StandardA4Frequency)
+	assert.Nil(t, err)
+	assert.InDelta(t, 261.626, frequency, 0.001)
+
+	frequency, _ = JustIntonation.Frequency(a4, c, StandardA4Frequency)
+	assert.InDelta(t, 261.626*5/3, frequency, 0.001)
+
+	frequency, _ = EqualTemperament.Frequency(a4, c, StandardA4Frequency)
+	assert.InDelta(t, 440.0, frequency, 0.001)
+
+	a, _ := ParseNote("A")
+	frequency, _ = PythagoreanTuning.Frequency(a4, a, 432)
+	assert.InDelta(t, 432.0, frequency, 0.001)
+}
+
+func TestTemperament_FindTemperament(t *testing.T) {
+	temperament, err := FindTemperament(" Meantone")
+	assert.Nil(t, err)
+	assert.Equal(t, QuarterCommaMeantone, temperament)
+
+	_, err = FindTemperament("werckmeister")
+	assert.Error(t, err)
+
+	assert.Len(t, Temperaments(), 4)
+}
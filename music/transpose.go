@@ -0,0 +1,271 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type TransposeDirection int
+
+const (
+	TransposeUp   TransposeDirection = 1
+	TransposeDown TransposeDirection = -1
+)
+
+// TransposeNotes moves every note by an interval. Notes are spelled by letter (e.g. F# up a major
+// second is G#), except that double sharps and flats are replaced by a simpler enharmonic.
+func TransposeNotes(notes []*Note, interval Interval, direction TransposeDirection) ([]*Note, error) {
+	return transposeNotes(notes, intervalTransposer(interval, direction))
+}
+
+// TransposeNotesToKey moves notes written in one key to another key, keeping their scale degrees.
+// Notes belonging to the destination key are spelled as in its scale.
+func TransposeNotesToKey(notes []*Note, from *Key, to *Key) ([]*Note, error) {
+	transposer, err := keyTransposer(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return transposeNotes(notes, transposer)
+}
+
+// TransposeChord moves a chord by an interval, keeping its quality and extensions. The chord is
+// taken as a tonic, so it is spelled in the key its root lands on (e.g. E7#9 up an augmented fourth
+// is Bb7#9, as A# major isn't a key). The symbol of the transposed chord uses the same suffix as
+// the original one (e.g. Bbm7/Ab up a major second is Cm7/Bb).
+func TransposeChord(chord *Chord, interval Interval, direction TransposeDirection) (*Chord, error) {
+	transposer, err := tonicTransposer(chord, interval, direction)
+	if err != nil {
+		return nil, err
+	}
+
+	return transposeChord(chord, transposer)
+}
+
+func TransposeChordToKey(chord *Chord, from *Key, to *Key) (*Chord, error) {
+	transposer, err := keyTransposer(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return transposeChord(chord, transposer)
+}
+
+// TransposeProgression transposes a progression of chord symbols separated by spaces or bars
+// (e.g. "C Am | F G7"), keeping the bars where they were. The first chord is taken as the tonic,
+// and every chord is spelled in the key it lands on (e.g. "Eb Cm Ab Bb7" down an augmented fourth
+// is "A F#m D E7").
+func TransposeProgression(progression string, interval Interval, direction TransposeDirection) (string, error) {
+	symbols := slices.DeleteFunc(strings.Fields(progression), func(symbol string) bool {
+		return symbol == "|"
+	})
+	if len(symbols) == 0 {
+		return "", fmt.Errorf("progression '%s' has no chords", progression)
+	}
+
+	tonicChord, err := ParseChord(symbols[0])
+	if err != nil {
+		return "", err
+	}
+
+	transposer, err := tonicTransposer(tonicChord, interval, direction)
+	if err != nil {
+		return "", err
+	}
+
+	return transposeProgression(progression, transposer)
+}
+
+func TransposeProgressionToKey(progression string, from *Key, to *Key) (string, error) {
+	transposer, err := keyTransposer(from, to)
+	if err != nil {
+		return "", err
+	}
+
+	return transposeProgression(progression, transposer)
+}
+
+func intervalTransposer(interval Interval, direction TransposeDirection) func(note *Note) (*Note, error) {
+	return func(note *Note) (*Note, error) {
+		transposed, err := note.moveBy(interval, int(direction))
+		if err != nil {
+			return nil, err
+		}
+
+		return simplifySpelling(transposed), nil
+	}
+}
+
+// tonicTransposer moves notes from the key a tonic chord implies (minor when the chord has a minor
+// third) to the key its root is moved to. Theoretical destination keys are swapped for their
+// enharmonic (e.g. G# major for Ab major), so notes never need more than a key signature's worth
+// of accidentals.
+func tonicTransposer(tonicChord *Chord, interval Interval, direction TransposeDirection) (func(note *Note) (*Note, error), error) {
+	mode := MajorKey
+	for _, tone := range tonicChord.Tones {
+		if tone.Interval == MinorThird {
+			mode = MinorKey
+		}
+	}
+
+	// the source key is only used for its tonic, so it doesn't need a valid key signature
+	from := &Key{Tonic: *tonicChord.Root, Mode: mode}
+
+	tonic, err := tonicChord.Root.moveBy(interval, int(direction))
+	if err != nil {
+		return nil, err
+	}
+
+	fifths := (&Key{Tonic: *tonic, Mode: mode}).Fifths()
+	for fifths > maxKeySignatureAccidentals {
+		fifths -= circleOfFifthsKeys
+	}
+	for fifths < -maxKeySignatureAccidentals {
+		fifths += circleOfFifthsKeys
+	}
+
+	to, err := KeyFromFifths(fifths, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyTransposer(from, to)
+}
+
+// keyTransposer moves notes by the interval between the tonics of both keys. Enharmonic keys
+// (e.g. F# and Gb major) are far apart by letter, which is exactly what keeps every scale degree
+// spelled the way the destination key expects. Both keys have to be in the same mode, as moving
+// from a major key to a minor one (e.g. G to Em) isn't a transposition.
+func keyTransposer(from *Key, to *Key) (func(note *Note) (*Note, error), error) {
+	if from.Mode != to.Mode {
+		return nil, fmt.Errorf("can't transpose from '%s' to '%s', both keys have to be major or minor", from.String(), to.String())
+	}
+
+	interval, err := from.Tonic.IntervalTo(&to.Tonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(note *Note) (*Note, error) {
+		pitchClass, err := note.PitchClass()
+		if err != nil {
+			return nil, err
+		}
+		transposedPitchClass := pitchClass.Transpose(interval.Semitones())
+
+		if transposed, err := note.Add(interval); err == nil && transposed.Symbol != DoubleSharp && transposed.Symbol != DoubleFlat {
+			return transposed, nil
+		}
+
+		return to.Spell(transposedPitchClass), nil
+	}, nil
+}
+
+func transposeNotes(notes []*Note, transpose func(note *Note) (*Note, error)) ([]*Note, error) {
+	transposed := make([]*Note, 0, len(notes))
+	for _, note := range notes {
+		transposedNote, err := transpose(note)
+		if err != nil {
+			return nil, err
+		}
+		transposed = append(transposed, transposedNote)
+	}
+
+	return transposed, nil
+}
+
+func transposeChord(chord *Chord, transpose func(note *Note) (*Note, error)) (*Chord, error) {
+	root, err := transpose(chord.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]Interval, len(chord.Tones))
+	for i, tone := range chord.Tones {
+		intervals[i] = tone.Interval
+	}
+
+	transposed, err := NewChord(root, intervals)
+	if err != nil {
+		return nil, err
+	}
+
+	if chord.IsSlashChord() {
+		transposed.Bass, err = transpose(chord.Bass)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if chord.Symbol != "" {
+		transposed.Symbol = EnglishNaming.NoteName(root) + chordSymbolSuffix(chord.Symbol)
+		if transposed.IsSlashChord() {
+			transposed.Symbol += "/" + EnglishNaming.NoteName(transposed.Bass)
+		}
+	}
+
+	return transposed, nil
+}
+
+func transposeProgression(progression string, transpose func(note *Note) (*Note, error)) (string, error) {
+	symbols := strings.Fields(progression)
+	for i, symbol := range symbols {
+		if symbol == "|" {
+			continue
+		}
+
+		chord, err := ParseChord(symbol)
+		if err != nil {
+			return "", err
+		}
+
+		transposed, err := transposeChord(chord, transpose)
+		if err != nil {
+			return "", err
+		}
+		symbols[i] = transposed.Symbol
+	}
+
+	return strings.Join(symbols, " "), nil
+}
+
+// chordSymbolSuffix returns what comes after the root of a chord symbol, leaving out the slash
+// bass (but not the slash of 6/9 chords).
+func chordSymbolSuffix(symbol string) string {
+	_, consumed, err := scanNote(symbol, EnglishNaming)
+	if err != nil {
+		return ""
+	}
+
+	suffix := symbol[consumed:]
+	if slashIndex := strings.LastIndex(suffix, "/"); slashIndex >= 0 {
+		if _, _, err := scanNote(suffix[slashIndex+1:], EnglishNaming); err == nil {
+			suffix = suffix[:slashIndex]
+		}
+	}
+
+	return suffix
+}
+
+// simplifySpelling replaces double sharps and flats with the enharmonic using at most one
+// accidental in the same direction (e.g. Fx becomes G and Bbb becomes A).
+func simplifySpelling(note *Note) *Note {
+	if note.Symbol != DoubleSharp && note.Symbol != DoubleFlat {
+		return note
+	}
+
+	for _, enharmonic := range note.EnharmonicNames {
+		sameDirection := (note.Symbol == DoubleSharp && enharmonic.Symbol == Sharp) ||
+			(note.Symbol == DoubleFlat && enharmonic.Symbol == Flat)
+
+		if enharmonic.Symbol == Natural || sameDirection {
+			simplified, err := NewNote(enharmonic.Name, enharmonic.Symbol)
+			if err == nil {
+				return simplified
+			}
+		}
+	}
+
+	return note
+}
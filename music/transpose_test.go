@@ -0,0 +1,165 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranspose_TransposeNotes(t *testing.T) {
+	notes := make([]*Note, 0)
+	for _, name := range []string{"C", "E", "G", "F#", "Bb"} {
+		note, _ := ParseNote(name)
+		notes = append(notes, note)
+	}
+
+	transposed, err := TransposeNotes(notes, MajorSecond, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"D", "F#", "A", "G#", "C"}, noteNames(transposed))
+
+	transposed, err = TransposeNotes(notes, MinorThird, TransposeDown)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A", "C#", "E", "D#", "G"}, noteNames(transposed))
+
+	// the letter follows the interval, while double accidentals are simplified
+	transposed, err = TransposeNotes(notes, AugmentedFourth, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"F#", "A#", "C#", "B#", "E"}, noteNames(transposed))
+
+	transposed, err = TransposeNotes(notes, DiminishedFifth, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Gb", "Bb", "Db", "C", "Fb"}, noteNames(transposed))
+
+	transposed, err = TransposeNotes(notes, AugmentedFifth, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"G#", "B#", "D#", "D", "F#"}, noteNames(transposed))
+}
+
+func TestTranspose_TransposeNotesToKey(t *testing.T) {
+	cMajor, _ := ParseKey("C")
+	dFlatMajor, _ := ParseKey("Db")
+	fSharpMajor, _ := ParseKey("F#")
+	gFlatMajor, _ := ParseKey("Gb")
+
+	notes := make([]*Note, 0)
+	for _, name := range []string{"C", "E", "F#", "G", "Bb", "B"} {
+		note, _ := ParseNote(name)
+		notes = append(notes, note)
+	}
+
+	transposed, err := TransposeNotesToKey(notes, cMajor, dFlatMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Db", "F", "G", "Ab", "Cb", "C"}, noteNames(transposed))
+
+	// enharmonic destinations spell the same degrees differently
+	transposed, err = TransposeNotesToKey(notes, cMajor, fSharpMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"F#", "A#", "B#", "C#", "E", "E#"}, noteNames(transposed))
+
+	transposed, err = TransposeNotesToKey(notes, cMajor, gFlatMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Gb", "Bb", "C", "Db", "Fb", "F"}, noteNames(transposed))
+
+	// notes that would need double accidentals are spelled by the key instead
+	bbMajor, _ := ParseKey("Bb")
+	aSharp, _ := ParseNote("A#")
+	transposed, err = TransposeNotesToKey([]*Note{aSharp}, bbMajor, fSharpMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"F#"}, noteNames(transposed))
+}
+
+func TestTranspose_TransposeChord(t *testing.T) {
+	testCases := []struct {
+		symbol    string
+		interval  Interval
+		direction TransposeDirection
+		expected  string
+	}{
+		{"C", MajorSecond, TransposeUp, "D"},
+		{"Am7", PerfectFourth, TransposeUp, "Dm7"},
+		{"Bbm7/Ab", MajorSecond, TransposeUp, "Cm7/Bb"},
+		{"G7/B", MajorSecond, TransposeDown, "F7/A"},
+		{"C6/9", MinorThird, TransposeUp, "Eb6/9"},
+		{"F#m7b5", MinorSecond, TransposeUp, "Gm7b5"},
+		// A# major isn't a key, so the chord is spelled in Bb major
+		{"E7#9", AugmentedFourth, TransposeUp, "Bb7#9"},
+		{"Am", AugmentedFourth, TransposeUp, "D#m"},
+	}
+
+	for _, testCase := range testCases {
+		chord, err := ParseChord(testCase.symbol)
+		assert.Nil(t, err)
+
+		transposed, err := TransposeChord(chord, testCase.interval, testCase.direction)
+		if assert.Nil(t, err, testCase.symbol) {
+			assert.Equal(t, testCase.expected, transposed.Symbol)
+
+			expected, _ := ParseChord(testCase.expected)
+			assert.Equal(t, noteNames(expected.Notes()), noteNames(transposed.Notes()), testCase.symbol)
+			assert.True(t, expected.Bass.Equals(transposed.Bass), testCase.symbol)
+		}
+	}
+}
+
+func TestTranspose_TransposeChordToKey(t *testing.T) {
+	gMajor, _ := ParseKey("G")
+	ebMajor, _ := ParseKey("Eb")
+
+	chord, _ := ParseChord("D7/F#")
+	transposed, err := TransposeChordToKey(chord, gMajor, ebMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bb7/D", transposed.Symbol)
+	assert.Equal(t, []string{"Bb", "D", "F", "Ab"}, noteNames(transposed.Notes()))
+}
+
+func TestTranspose_TransposeProgression(t *testing.T) {
+	transposed, err := TransposeProgression("C Am | F G7", MajorSecond, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, "D Bm | G A7", transposed)
+
+	// capo on the 3rd fret: shapes to play for a song in Eb
+	transposed, err = TransposeProgression("Eb Cm Ab Bb7", MinorThird, TransposeDown)
+	assert.Nil(t, err)
+	assert.Equal(t, "C Am F G7", transposed)
+
+	// chords are spelled in the key the first chord lands on, whichever way the interval is spelled
+	transposed, err = TransposeProgression("Eb Cm Ab Bb7", AugmentedFourth, TransposeDown)
+	assert.Nil(t, err)
+	assert.Equal(t, "A F#m D E7", transposed)
+
+	transposed, err = TransposeProgression("Eb Cm Ab Bb7", DiminishedFifth, TransposeDown)
+	assert.Nil(t, err)
+	assert.Equal(t, "A F#m D E7", transposed)
+
+	transposed, err = TransposeProgression("E C#m | A B7", AugmentedFourth, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bb Gm | Eb F7", transposed)
+
+	transposed, err = TransposeProgression("Dm Bb Gm A7", MinorSecond, TransposeUp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Ebm Cb Abm Bb7", transposed)
+
+	_, err = TransposeProgression("C Hm", MajorSecond, TransposeUp)
+	assert.Error(t, err)
+
+	_, err = TransposeProgression("| |", MajorSecond, TransposeUp)
+	assert.Error(t, err)
+
+	cMajor, _ := ParseKey("C")
+	aMajor, _ := ParseKey("A")
+	transposed, err = TransposeProgressionToKey("C Em/B | F Fm C", cMajor, aMajor)
+	assert.Nil(t, err)
+	assert.Equal(t, "A C#m/G# | D Dm A", transposed)
+
+	aMinor, _ := ParseKey("Am")
+	cSharpMinor, _ := ParseKey("C#m")
+	transposed, err = TransposeProgressionToKey("Am Dm | E7 Am", aMinor, cSharpMinor)
+	assert.Nil(t, err)
+	assert.Equal(t, "C#m F#m | G#7 C#m", transposed)
+
+	// G to Em would be a relative key, not a transposition
+	gMajor, _ := ParseKey("G")
+	eMinor, _ := ParseKey("Em")
+	_, err = TransposeProgressionToKey("G D/F# Em C", gMajor, eMinor)
+	assert.Error(t, err)
+}